@@ -14,7 +14,8 @@ var BuildTime string
 var CommitHash string
 var Release string
 
-const MAX_CSV_FILE_SIZE = 10 * 1024 * 1024 // 10 MB
+const MAX_CSV_FILE_SIZE = 10 * 1024 * 1024     // 10 MB
+const MAX_PETSCAN_FILE_SIZE = 50 * 1024 * 1024 // 50 MB
 type SentryConfig struct {
 	DSN         string            `mapstructure:"DSN"`
 	Environment string            `mapstructure:"Environment"`
@@ -76,9 +77,16 @@ type AuthenticationConfiguration struct {
 	RSAPrivateKeyPath          string               `mapstructure:"RSAPrivateKeyPath"`
 	RSAPublicKeyPath           string               `mapstructure:"RSAPublicKeyPath"`
 }
+type SchedulerConfiguration struct {
+	// The interval (in seconds) between two consecutive checks of the round schedule
+	IntervalSeconds int `mapstructure:"IntervalSeconds"`
+	// If true, rounds would be marked as completed at their end date, otherwise they would be paused
+	CompleteOnEndDate bool `mapstructure:"CompleteOnEndDate"`
+}
 type TaskManagerConfiguration struct {
-	Host string `mapstructure:"Host"`
-	Port string `mapstructure:"Port"`
+	Host      string                 `mapstructure:"Host"`
+	Port      string                 `mapstructure:"Port"`
+	Scheduler SchedulerConfiguration `mapstructure:"Scheduler"`
 }
 type ApplicationConfiguration struct {
 	Server       ServerConfiguration         `mapstructure:"Server"`
@@ -24,6 +24,8 @@ type MediaResult struct {
 	ThumbURL            *string
 	ThumbWidth          *uint64
 	ThumbHeight         *uint64
+	// Article would only be set for the articles of the wikipedia campaigns
+	Article *ArticleSubmission
 }
 type WikiMediaBaseResponse struct {
 	Error *struct {
@@ -112,6 +114,8 @@ type Revision struct {
 	Comment   string                `json:"comment"`
 	Slots     *Slots                `json:"slots,omitempty"` // Optional, may not be present in all revisions
 	Page      Page                  `json:"-"`
+	// Size of the revision in bytes, only present if requested by `rvprop=size`
+	Size uint64 `json:"size"`
 }
 
 type RevisionPage struct {
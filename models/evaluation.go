@@ -3,6 +3,9 @@ package models
 import (
 	"nokib/campwiz/models/types"
 	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gen"
 )
 
 type EvaluationType string
@@ -34,6 +37,12 @@ type Evaluation struct {
 	SkipExpirationAt *time.Time `json:"skipExpirationAt" gorm:"type:datetime"`
 	// Round              *Round         `json:"-" gorm:"foreignKey:RoundID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	DistributionTaskID IDType `json:"distributionTaskId" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Rank is the position of the submission in the ordered list of the jury (1 being the best), only for ranking rounds
+	Rank *uint `json:"rank" gorm:"default:null"`
+	// CriteriaScores are the scores of the individual criteria, only for rounds with a rubric
+	CriteriaScores *datatypes.JSONType[CriteriaScores] `json:"criteriaScores" gorm:"type:json;default:null"`
+	// SkipCount is the number of times the current jury has skipped this assignment
+	SkipCount uint `json:"skipCount" gorm:"default:0"`
 }
 type EvaluationFilter struct {
 	Type          EvaluationType         `form:"type"`
@@ -57,6 +66,16 @@ type GetEvaluationQueryFilter struct {
 	IncludeSkipped bool `form:"includeSkipped"`
 	CommonFilter
 }
+type SkipRequest struct {
+	// For how long the assignment would be hidden from the jury (default 24 hours)
+	DurationMinutes int `json:"durationMinutes"`
+}
+type SwapRequest struct {
+	// The jury whose assignments would be swapped, default is the current user (only coordinators can swap on behalf of others)
+	JuryUsername WikimediaUsernameType `json:"juryUsername"`
+	// The unevaluated assignments to give away, default is all the unevaluated assignments of the jury
+	EvaluationIDs []IDType `json:"evaluationIds"`
+}
 type NewEvaluationRequest struct {
 	SubmissionID IDType
 	Times        int
@@ -67,8 +86,13 @@ type EvaluationListResponseWithCurrentStats struct {
 	TotalAssignmentCount int `json:"totalAssignmentCount"`
 }
 type Evaluator interface {
-	// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `round_id` = @roundID AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` <= @quorum` AND `round_id` = @roundID)
-	RemoveRedundantEvaluation(roundID string, quorum int)
+	// RemoveRedundantEvaluation deletes the pending assignments of the submissions that have already reached the quorum.
+	// It relies on the `evaluation_count` of the submissions, so the submission statistics must be up to date.
+	//
+	// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `round_id` = @roundID AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= @quorum AND `round_id` = @roundID)
+	RemoveRedundantEvaluation(roundID string, quorum int) (gen.RowsAffected, error)
+	// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= @quorum AND `submission_id` IN (@submissionIds))
+	RemoveRedundantEvaluationBySubmissionIds(submissionIds []string, quorum int) (gen.RowsAffected, error)
 	//SELECT evaluation_id, judge_id, submission_id FROM evaluations WHERE judge_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id IN (SELECT submission_id FROM evaluations WHERE judge_id=@amiJudgeID AND round_id=@roundId AND score IS NULL) AND round_id=@roundId) AND round_id=@roundId AND score IS NULL AND submission_id NOT IN (SELECT submission_id FROM evaluations WHERE judge_id=@amiJudgeID AND round_id=@roundId) GROUP BY submission_id ORDER BY RAND() LIMIT @limit;
 	FetchTargetSwappables(roundId string, amiJudgeID string, limit int) ([]*Evaluation, error)
 }
@@ -0,0 +1,38 @@
+package models
+
+import "nokib/campwiz/models/types"
+
+// ScoreNormalization is the strategy used to correct the bias of the individual juries
+// (e.g. a jury scoring everything above 80) when the results of a round are computed
+type ScoreNormalization string
+
+const (
+	// ScoreNormalizationNone is the plain average of the raw scores
+	ScoreNormalizationNone ScoreNormalization = "none"
+	// ScoreNormalizationZScore converts the scores of every jury into standard scores
+	// and maps them back to the scale of the round
+	ScoreNormalizationZScore ScoreNormalization = "zscore"
+	// ScoreNormalizationRankPercentile converts the scores of every jury into the percentile rank among the scores of the same jury
+	ScoreNormalizationRankPercentile ScoreNormalization = "percentile"
+	// ScoreNormalizationTrimmedMean drops the most extreme scores of every submission before averaging
+	ScoreNormalizationTrimmedMean ScoreNormalization = "trimmed"
+)
+
+func (s ScoreNormalization) IsValid() bool {
+	switch s {
+	case ScoreNormalizationNone, ScoreNormalizationZScore, ScoreNormalizationRankPercentile, ScoreNormalizationTrimmedMean:
+		return true
+	}
+	return false
+}
+
+// JudgeScore is a single score given by a jury to a submission
+type JudgeScore struct {
+	JudgeID      IDType
+	SubmissionID types.SubmissionIDType
+	Score        float64
+}
+type ResultSummaryQuery struct {
+	// The normalization strategy, defaults to the one of the round
+	Normalization ScoreNormalization `form:"normalization"`
+}
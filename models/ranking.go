@@ -0,0 +1,39 @@
+package models
+
+import (
+	"nokib/campwiz/models/types"
+	"time"
+)
+
+type RankingMethod string
+
+const (
+	// RankingMethodBorda awards every submission one point for each submission ranked below it
+	RankingMethodBorda RankingMethod = "borda"
+	// RankingMethodSchulze orders the submissions by the strength of their strongest paths in the pairwise preference graph
+	RankingMethodSchulze RankingMethod = "schulze"
+)
+
+// RankingPreference is a pairwise preference of a jury between two submissions of a ranking round
+type RankingPreference struct {
+	PreferenceID IDType                 `json:"preferenceId" gorm:"primaryKey"`
+	RoundID      IDType                 `json:"roundId" gorm:"index;uniqueIndex:idx_preference_judge_pair"`
+	JudgeID      IDType                 `json:"judgeId" gorm:"index;uniqueIndex:idx_preference_judge_pair"`
+	WinnerID     types.SubmissionIDType `json:"winnerId" gorm:"uniqueIndex:idx_preference_judge_pair"`
+	LoserID      types.SubmissionIDType `json:"loserId" gorm:"uniqueIndex:idx_preference_judge_pair"`
+	CreatedAt    *time.Time             `json:"createdAt" gorm:"autoCreateTime"`
+	Round        *Round                 `json:"-" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Judge        *Role                  `json:"-" gorm:"foreignKey:JudgeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// RankingRequest is the ordered list of submissions (best first) assigned to a jury
+type RankingRequest struct {
+	SubmissionIDs []types.SubmissionIDType `json:"submissionIds" binding:"required"`
+}
+type PairwisePreference struct {
+	WinnerID types.SubmissionIDType `json:"winnerId" binding:"required"`
+	LoserID  types.SubmissionIDType `json:"loserId" binding:"required"`
+}
+type PairwisePreferenceRequest struct {
+	Preferences []PairwisePreference `json:"preferences" binding:"required"`
+}
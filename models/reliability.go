@@ -0,0 +1,46 @@
+package models
+
+import "nokib/campwiz/models/types"
+
+type ReliabilityQuery struct {
+	// The number of the most disputed submissions to return
+	DisputedLimit int `form:"disputedLimit,default=20"`
+}
+
+// JuryReliability is the summary of a jury in the report.
+// In a secret ballot round, the judge ID is an opaque label and the average score is null until the round is completed.
+type JuryReliability struct {
+	JuryStatistics
+	Username     WikimediaUsernameType `json:"username"`
+	AverageScore *float64              `json:"averageScore"`
+}
+
+// JuryPairAgreement is the agreement between two juries on the submissions they both have evaluated.
+// Any of the coefficients would be null if it is undefined (e.g. not enough variation).
+// In a secret ballot round, the juries of the pair are left out until the round is completed.
+type JuryPairAgreement struct {
+	JudgeA                 IDType   `json:"judgeA,omitempty"`
+	JudgeB                 IDType   `json:"judgeB,omitempty"`
+	CommonSubmissions      int      `json:"commonSubmissions"`
+	MeanAbsoluteDifference float64  `json:"meanAbsoluteDifference"`
+	KrippendorffAlpha      *float64 `json:"krippendorffAlpha"`
+	FleissKappa            *float64 `json:"fleissKappa"`
+}
+type DisputedSubmission struct {
+	SubmissionID    types.SubmissionIDType `json:"submissionId"`
+	Name            string                 `json:"name"`
+	AverageScore    float64                `json:"averageScore"`
+	Variance        float64                `json:"variance"`
+	EvaluationCount int                    `json:"evaluationCount"`
+}
+type ReliabilityReport struct {
+	RoundID IDType `json:"roundId"`
+	// Krippendorff's alpha over all the evaluated submissions, using the interval metric
+	KrippendorffAlpha *float64 `json:"krippendorffAlpha"`
+	// Fleiss' kappa over all the evaluated submissions, every distinct score is a category
+	FleissKappa      *float64             `json:"fleissKappa"`
+	TotalEvaluations int                  `json:"totalEvaluations"`
+	Juries           []JuryReliability    `json:"juries"`
+	Pairs            []JuryPairAgreement  `json:"pairs"`
+	MostDisputed     []DisputedSubmission `json:"mostDisputed"`
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"nokib/campwiz/consts"
+	"nokib/campwiz/models/types"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -33,7 +35,84 @@ type Role struct {
 	User            *User                  `json:"-" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Project         *Project               `json:"-"  gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	DeletedAt       *gorm.DeletedAt        `json:"deletedAt"`
+	// Capacity is the relative amount of work a jury can take in the capacity weighted distribution (0 for the average of the others)
+	Capacity int `json:"capacity" gorm:"default:0"`
 }
+type ConflictType string
+
+const (
+	// The jury would not evaluate any submission of a participant
+	ConflictTypeParticipant ConflictType = "participant"
+	// The jury would not evaluate a specific submission
+	ConflictTypeSubmission ConflictType = "submission"
+)
+
+// ConflictOfInterest is declared by a jury of a round, the distribution would never
+// assign a conflicted submission to that jury.
+type ConflictOfInterest struct {
+	ConflictID    IDType                  `json:"conflictId" gorm:"primaryKey"`
+	RoleID        IDType                  `json:"roleId" gorm:"index;not null"`
+	RoundID       IDType                  `json:"roundId" gorm:"index;not null"`
+	Type          ConflictType            `json:"type" gorm:"not null"`
+	ParticipantID *IDType                 `json:"participantId" gorm:"default:null"`
+	SubmissionID  *types.SubmissionIDType `json:"submissionId" gorm:"default:null"`
+	Reason        string                  `json:"reason"`
+	CreatedAt     *time.Time              `json:"createdAt" gorm:"autoCreateTime"`
+	Role          *Role                   `json:"-" gorm:"foreignKey:RoleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Round         *Round                  `json:"-" gorm:"foreignKey:RoundID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+type ConflictOfInterestRequest struct {
+	// The participants whose submissions the jury would not evaluate
+	Participants []WikimediaUsernameType `json:"participants"`
+	// The submissions the jury would not evaluate
+	SubmissionIDs []types.SubmissionIDType `json:"submissionIds"`
+	Reason        string                   `json:"reason"`
+}
+
+type JuryCapacityRequest struct {
+	// The capacity of each jury by their username
+	Capacities map[WikimediaUsernameType]int `json:"capacities"`
+}
+
+// ConflictIndex looks up the declared conflicts of the juries of a round
+type ConflictIndex struct {
+	participants map[IDType]map[IDType]bool
+	submissions  map[IDType]map[types.SubmissionIDType]bool
+}
+
+func NewConflictIndex(conflicts []ConflictOfInterest) *ConflictIndex {
+	index := &ConflictIndex{
+		participants: map[IDType]map[IDType]bool{},
+		submissions:  map[IDType]map[types.SubmissionIDType]bool{},
+	}
+	for _, conflict := range conflicts {
+		if conflict.ParticipantID != nil {
+			if index.participants[conflict.RoleID] == nil {
+				index.participants[conflict.RoleID] = map[IDType]bool{}
+			}
+			index.participants[conflict.RoleID][*conflict.ParticipantID] = true
+		}
+		if conflict.SubmissionID != nil {
+			if index.submissions[conflict.RoleID] == nil {
+				index.submissions[conflict.RoleID] = map[types.SubmissionIDType]bool{}
+			}
+			index.submissions[conflict.RoleID][*conflict.SubmissionID] = true
+		}
+	}
+	return index
+}
+
+// Conflicts reports whether the jury has a conflict with the submission
+func (c *ConflictIndex) Conflicts(roleID IDType, submission *Submission) bool {
+	if c == nil || submission == nil {
+		return false
+	}
+	if c.submissions[roleID][submission.SubmissionID] {
+		return true
+	}
+	return c.participants[roleID][submission.ParticipantID] || c.participants[roleID][submission.SubmittedByID]
+}
+
 type RoleFilter struct {
 	CommonFilter
 	ProjectID       IDType                  `form:"projectId"`
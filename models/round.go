@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
 
 // These are the restrictions that are applied to the articles that are submitted to the campaign
 type RoundCommonRestrictions struct {
@@ -8,6 +12,8 @@ type RoundCommonRestrictions struct {
 	AllowMultipleJudgement bool   `json:"allowMultipleJudgement"`
 	SecretBallot           bool   `json:"secretBallot"`
 	Blacklist              string `json:"blacklist"`
+	// Whether the identity of the author is hidden from the juries until the results
+	BlindJudging bool `json:"blindJudging"`
 }
 type RoundStatus string
 type EvaluationResult struct {
@@ -123,6 +129,16 @@ type RoundWritable struct {
 	Quorum           uint           `json:"quorum" gorm:"default:1"`
 	Type             EvaluationType `json:"type"`
 	RoundRestrictions
+	// Rubric is the optional list of criteria the submissions are scored with
+	Rubric *datatypes.JSONType[Rubric] `json:"rubric" gorm:"type:json;default:null"`
+	// ScoreNormalization is the default normalization strategy used for the results of the round
+	ScoreNormalization ScoreNormalization `json:"scoreNormalization" gorm:"default:'none'"`
+	// SkipReassignmentThreshold is the number of the distinct juries skipping a submission after which its assignment is handed to another jury (0 to never reassign)
+	SkipReassignmentThreshold uint `json:"skipReassignmentThreshold" gorm:"default:0"`
+	// DistributionStrategy is the name of the strategy used to distribute the evaluations of the round (empty for the server default)
+	DistributionStrategy string `json:"distributionStrategy" gorm:"default:null"`
+	// TrimRedundantEvaluations deletes the pending assignments of a submission as soon as it reaches the quorum
+	TrimRedundantEvaluations bool `json:"trimRedundantEvaluations" gorm:"default:false"`
 }
 type Round struct {
 	RoundID                   IDType      `json:"roundId" gorm:"primaryKey"`
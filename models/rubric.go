@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RubricCriterion is a single criterion of a multi-criteria rubric (e.g. "sources", "length", "quality")
+type RubricCriterion struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	// Weight is the relative importance of the criterion, it does not need to sum up to 1
+	Weight float64 `json:"weight"`
+	// MaximumScore is the upper bound of the scale of the criterion, the lower bound is always 0
+	MaximumScore ScoreType `json:"maximumScore"`
+}
+
+// Rubric is the list of criteria a round is evaluated with
+type Rubric []RubricCriterion
+
+// CriteriaScores maps the name of a criterion to the score given for it
+type CriteriaScores map[string]ScoreType
+
+func (r Rubric) Validate() error {
+	seen := map[string]bool{}
+	totalWeight := 0.0
+	for _, criterion := range r {
+		if criterion.Name == "" {
+			return errors.New("rubric criterion name is required")
+		}
+		if seen[criterion.Name] {
+			return fmt.Errorf("rubric criterion %s is defined more than once", criterion.Name)
+		}
+		seen[criterion.Name] = true
+		if criterion.Weight < 0 {
+			return fmt.Errorf("rubric criterion %s has a negative weight", criterion.Name)
+		}
+		if criterion.MaximumScore <= 0 {
+			return fmt.Errorf("rubric criterion %s must have a positive maximum score", criterion.Name)
+		}
+		totalWeight += criterion.Weight
+	}
+	if len(r) > 0 && totalWeight == 0 {
+		return errors.New("at least one rubric criterion must have a positive weight")
+	}
+	return nil
+}
+
+// WeightedScore combines the criteria scores into a single score between 0 and MAXIMUM_EVALUATION_SCORE.
+// Every criterion is first scaled to the maximum evaluation score and then weighted.
+func (r Rubric) WeightedScore(scores CriteriaScores) (ScoreType, error) {
+	for name := range scores {
+		if !r.Has(name) {
+			return 0, fmt.Errorf("unknown rubric criterion %s", name)
+		}
+	}
+	total := 0.0
+	totalWeight := 0.0
+	for _, criterion := range r {
+		score, ok := scores[criterion.Name]
+		if !ok {
+			return 0, fmt.Errorf("no score is given for rubric criterion %s", criterion.Name)
+		}
+		if score < 0 || score > criterion.MaximumScore {
+			return 0, fmt.Errorf("score of rubric criterion %s must be between 0 and %v", criterion.Name, criterion.MaximumScore)
+		}
+		total += criterion.Weight * float64(score/criterion.MaximumScore*MAXIMUM_EVALUATION_SCORE)
+		totalWeight += criterion.Weight
+	}
+	if totalWeight == 0 {
+		return 0, errors.New("rubric has no weighted criterion")
+	}
+	return ScoreType(total / totalWeight), nil
+}
+func (r Rubric) Has(name string) bool {
+	for _, criterion := range r {
+		if criterion.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -50,6 +50,8 @@ type ArticleSubmission struct {
 	TotalWords uint64 `json:"totalwords" gorm:"default:0"`
 	AddedBytes uint64 `json:"addedbytes" gorm:"default:0"`
 	AddedWords uint64 `json:"addedwords" gorm:"default:0"`
+	// Whether the article was created (rather than expanded) by the participant
+	IsNewArticle bool `json:"isNewArticle" gorm:"default:false"`
 }
 type ImageSubmission struct {
 	Width      uint64 `json:"width"`
@@ -73,6 +75,16 @@ type MediaSubmission struct {
 	ImageSubmission
 	AudioVideoSubmission
 }
+
+// HideAuthor removes everything that could identify the author of the submission
+// (used for the rounds with blind judging)
+func (s *Submission) HideAuthor() {
+	s.Author = ""
+	s.CreditHTML = ""
+	s.SubmittedByID = ""
+	s.ParticipantID = ""
+}
+
 type Submission struct {
 	SubmissionID types.SubmissionIDType `json:"submissionId" gorm:"primaryKey"`
 	Name         string                 `json:"title"`
@@ -99,11 +111,14 @@ type Submission struct {
 	AssignmentCount uint `json:"assignmentCount" gorm:"default:0"`
 	// The number of times the submission has been evaluated by the juries
 	EvaluationCount uint `json:"evaluationCount" gorm:"default:0"`
+	// The number of the distinct juries who have skipped the submission, it is kept when the submission is reassigned
+	SkippedJuryCount uint `json:"skippedJuryCount" gorm:"default:0"`
 	// The task that was used to distribute the submission to the juries
 	DistributionTask *Task `json:"-" gorm:"foreignKey:DistributionTaskID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	// The task that was used to import the submission from the external source
 	ImportTask *Task `json:"-" gorm:"foreignKey:ImportTaskID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	MediaSubmission
+	ArticleSubmission
 }
 type SubmissionSelectID struct {
 	SubmissionID types.SubmissionIDType
@@ -115,10 +130,16 @@ type SubmissionResult struct {
 	Score           ScoreType `json:"score"`
 	EvaluationCount int       `json:"juryCount"`
 	MediaType       MediaType `json:"type"`
+	// Rank is only set for the ranking rounds
+	Rank int `json:"rank,omitempty" gorm:"-"`
 }
 type SubmissionResultQuery struct {
 	CommonFilter
 	Type []MediaType `form:"type" collectionFormat:"multi"`
+	// The aggregation method for the ranking rounds, defaults to borda
+	Method RankingMethod `form:"method"`
+	// The normalization strategy of the scores, defaults to the one of the round
+	Normalization ScoreNormalization `form:"normalization"`
 }
 type SubmissionStatistics struct {
 	SubmissionID    types.SubmissionIDType
@@ -135,9 +156,30 @@ type CommonsSubmissionEntry struct {
 	FrSize      uint64 `json:"frSize"`
 	FtMediaType string `json:"ftMediaType"`
 }
+
+// CommonsCategoryLink is a row of the categorylinks table of the Commons replica
+type CommonsCategoryLink struct {
+	ClFrom    uint64 `json:"clFrom"`
+	ClTo      string `json:"clTo"`
+	PageTitle string `json:"pageTitle"`
+}
+type CommonsCategoryTraverser interface {
+	// FetchSubcategories fetches the direct subcategories of the categories, the title of a subcategory is in PageTitle.
+	//
+	// SELECT cl_from, cl_to, page_title FROM categorylinks JOIN page ON cl_from = page_id WHERE cl_to IN (@categories) AND cl_type = 'subcat' AND page_namespace = 14
+	FetchSubcategories(categories []string) ([]CommonsCategoryLink, error)
+	// FetchCategoryMembership fetches the pages which are directly in any of the categories
+	//
+	// SELECT cl_from, cl_to FROM categorylinks WHERE cl_to IN (@categories) AND cl_from IN (@pageIds)
+	FetchCategoryMembership(categories []string, pageIds []uint64) ([]CommonsCategoryLink, error)
+}
 type SubmissionStatisticsFetcher interface {
 	// SELECT COUNT(*) AS `AssignmentCount`, SUM(`score` IS NOT NULL) AS EvaluationCount, `submission_id`  FROM `evaluations`  WHERE `round_id` = @round_id GROUP BY `submission_id`
 	FetchByRoundID(round_id string) ([]SubmissionStatistics, error)
+	// TriggerBySubmissionIds aggregates the evaluations of the submissions. For the rounds with a rubric,
+	// the score of every evaluation is already the weighted total of its criteria (see Rubric.WeightedScore)
+	// so the average is the weighted aggregation of the rubric.
+	//
 	// UPDATE `submissions` JOIN (SELECT AVG(`evaluations`.`score`) As `Score`, COUNT(`evaluations`.`evaluation_id`) AS `AssignmentCount`, SUM(`evaluations`.`score` IS NOT NULL) AS `EvaluationCount`,`evaluations`.`submission_id` FROM `evaluations` WHERE  `evaluations`.submission_id IN (@submissionIds) GROUP BY `evaluations`.`submission_id`) AS `e` ON `submissions`.`submission_id` = `e`.`submission_id` SET `submissions`.`assignment_count` = `e`.`AssignmentCount`, `submissions`.`evaluation_count` = `e`.`EvaluationCount`, `submissions`.`score` = `e`.`Score` WHERE `submissions`.`submission_id` = `e`.`submission_id`
 	TriggerBySubmissionIds(submissionIds []string) (gen.RowsAffected, error)
 	// UPDATE `submissions` JOIN (SELECT AVG(`evaluations`.`score`) As `Score`, COUNT(`evaluations`.`evaluation_id`) AS `AssignmentCount`, SUM(`evaluations`.`score` IS NOT NULL) AS `EvaluationCount`,`evaluations`.`submission_id` FROM `evaluations` WHERE `evaluations`.`round_id` = @round_id GROUP BY `evaluations`.`submission_id`) AS `e` ON `submissions`.`submission_id` = `e`.`submission_id` SET `submissions`.`assignment_count` = `e`.`AssignmentCount`, `submissions`.`evaluation_count` = `e`.`EvaluationCount`, `submissions`.`score` = `e`.`Score` WHERE `submissions`.`round_id` = @round_id
@@ -175,6 +217,60 @@ type SubmissionFetcher interface {
 	// AND
 	// 	file_deleted=false ORDER BY `page_id` ASC LIMIT @limit;
 	FetchSubmissionsFromCommonsDBByPageID(pageids []uint64, limit int) ([]CommonsSubmissionEntry, error)
+
+	// FetchSubmissionsFromCommonsDBByTitle fetches submissions from the Commons database by the file name (without the `File:` prefix).
+	//
+	// SELECT
+	// 	page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type
+	// FROM
+	// 	page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes
+	// ON
+	// 	ft_id = file_type AND fr_id=file_latest
+	// AND
+	// 	user_id=actor_user
+	// AND
+	// 	file_name=page_title
+	// AND
+	// 	actor_id=fr_actor
+	// WHERE
+	// 	page_namespace = 6
+	// AND
+	// 	page_title IN (@titles)
+	// AND
+	// 	fr_deleted = false
+	// AND
+	// 	file_deleted=false ORDER BY `page_id` ASC LIMIT @limit;
+	FetchSubmissionsFromCommonsDBByTitle(titles []string, limit int) ([]CommonsSubmissionEntry, error)
+
+	// FetchSubmissionsFromCommonsDBByUploader fetches the files whose latest revision was uploaded by any of the users (user_name, with spaces).
+	//
+	// SELECT
+	// 	page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type
+	// FROM
+	// 	page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes
+	// ON
+	// 	ft_id = file_type AND fr_id=file_latest
+	// AND
+	// 	user_id=actor_user
+	// AND
+	// 	file_name=page_title
+	// AND
+	// 	actor_id=fr_actor
+	// WHERE
+	// 	page_namespace = 6
+	// AND
+	// 	user_name IN (@usernames)
+	// AND
+	// 	ft_media_type IN (@allowedMediaTypes)
+	// AND
+	// 	page_id > @startPageID
+	// AND
+	// 	@minimumTimestamp <= fr_timestamp AND fr_timestamp < @maximumTimestamp
+	// AND
+	// 	fr_deleted = false
+	// AND
+	// 	file_deleted=false ORDER BY `page_id` ASC LIMIT @limit;
+	FetchSubmissionsFromCommonsDBByUploader(usernames []string, startPageID uint64, minimumTimestamp uint64, maximumTimestamp uint64, limit int, allowedMediaTypes []string) ([]CommonsSubmissionEntry, error)
 }
 
 func (c *CommonsSubmissionEntry) GetURL() string {
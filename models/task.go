@@ -13,8 +13,16 @@ const (
 	TaskTypeImportFromCommons       TaskType = "submissions.import.commons"
 	TaskTypeImportFromPreviousRound TaskType = "submissions.import.previous"
 	TaskTypeImportFromCSV           TaskType = "submissions.import.csv"
+	TaskTypeImportFromPagePile      TaskType = "submissions.import.pagepile"
+	TaskTypeImportFromPetScan       TaskType = "submissions.import.petscan"
+	TaskTypeImportFromUploaders     TaskType = "submissions.import.uploaders"
+	TaskTypeImportFromWikipedia     TaskType = "submissions.import.wikipedia"
 	TaskTypeDistributeEvaluations   TaskType = "assignments.distribute"
 	TaskTypeRandomizeAssignments    TaskType = "assignments.randomize"
+	TaskTypeSwapAssignments         TaskType = "assignments.swap"
+	TaskTypeUndoDistribution        TaskType = "assignments.undo"
+	TaskTypeRebalanceAssignments    TaskType = "assignments.rebalance"
+	TaskTypeTrimAssignments         TaskType = "assignments.trim"
 )
 const (
 	TaskStatusPending TaskStatus = "pending"
@@ -55,6 +63,9 @@ type TaskData struct {
 	Task *Task `json:"-" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
+// RoundStatusTaskDataKey is the key of the status a round had before a distribution task started
+const RoundStatusTaskDataKey = "round-status"
+
 // `id`        INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 //
 //	`pageid`    INTEGER NOT NULL, -- pageid of the page on the target wiki
@@ -21,9 +21,13 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type ImportFromCommonsCategoryRequest struct {
-	CommonsCategory      []string `protobuf:"bytes,1,rep,name=commons_category,json=commonsCategory,proto3" json:"commons_category,omitempty"`
-	RoundId              string   `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	TaskId               string   `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CommonsCategory []string `protobuf:"bytes,1,rep,name=commons_category,json=commonsCategory,proto3" json:"commons_category,omitempty"`
+	RoundId         string   `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId          string   `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The number of the levels of the subcategories to import from, 0 imports only the given categories
+	Depth int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The files in these categories are skipped and their subcategories are not traversed
+	ExcludedCategories   []string `protobuf:"bytes,5,rep,name=excluded_categories,json=excludedCategories,proto3" json:"excluded_categories,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -75,6 +79,20 @@ func (m *ImportFromCommonsCategoryRequest) GetTaskId() string {
 	return ""
 }
 
+func (m *ImportFromCommonsCategoryRequest) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *ImportFromCommonsCategoryRequest) GetExcludedCategories() []string {
+	if m != nil {
+		return m.ExcludedCategories
+	}
+	return nil
+}
+
 type ImportFromPreviousRoundRequest struct {
 	RoundId              string    `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
 	TaskId               string    `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -336,6 +354,280 @@ func (m *ImportFromCampWizV1Request) GetTaskId() string {
 	return ""
 }
 
+type ImportFromPagePileRequest struct {
+	RoundId              string   `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId               string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PagepileId           int64    `protobuf:"varint,3,opt,name=pagepile_id,json=pagepileId,proto3" json:"pagepile_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFromPagePileRequest) Reset()         { *m = ImportFromPagePileRequest{} }
+func (m *ImportFromPagePileRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportFromPagePileRequest) ProtoMessage()    {}
+func (*ImportFromPagePileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{5}
+}
+
+func (m *ImportFromPagePileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFromPagePileRequest.Unmarshal(m, b)
+}
+func (m *ImportFromPagePileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFromPagePileRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportFromPagePileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFromPagePileRequest.Merge(m, src)
+}
+func (m *ImportFromPagePileRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportFromPagePileRequest.Size(m)
+}
+func (m *ImportFromPagePileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFromPagePileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFromPagePileRequest proto.InternalMessageInfo
+
+func (m *ImportFromPagePileRequest) GetRoundId() string {
+	if m != nil {
+		return m.RoundId
+	}
+	return ""
+}
+
+func (m *ImportFromPagePileRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ImportFromPagePileRequest) GetPagepileId() int64 {
+	if m != nil {
+		return m.PagepileId
+	}
+	return 0
+}
+
+type ImportFromPetScanRequest struct {
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The path to the JSON result, must be accessible by the task manager
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// If true, the file is deleted once it is read (e.g. an uploaded result)
+	Temporary            bool     `protobuf:"varint,4,opt,name=temporary,proto3" json:"temporary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFromPetScanRequest) Reset()         { *m = ImportFromPetScanRequest{} }
+func (m *ImportFromPetScanRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportFromPetScanRequest) ProtoMessage()    {}
+func (*ImportFromPetScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{6}
+}
+
+func (m *ImportFromPetScanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFromPetScanRequest.Unmarshal(m, b)
+}
+func (m *ImportFromPetScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFromPetScanRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportFromPetScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFromPetScanRequest.Merge(m, src)
+}
+func (m *ImportFromPetScanRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportFromPetScanRequest.Size(m)
+}
+func (m *ImportFromPetScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFromPetScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFromPetScanRequest proto.InternalMessageInfo
+
+func (m *ImportFromPetScanRequest) GetRoundId() string {
+	if m != nil {
+		return m.RoundId
+	}
+	return ""
+}
+
+func (m *ImportFromPetScanRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ImportFromPetScanRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ImportFromPetScanRequest) GetTemporary() bool {
+	if m != nil {
+		return m.Temporary
+	}
+	return false
+}
+
+type ImportFromUploadersRequest struct {
+	RoundId   string   `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId    string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Usernames []string `protobuf:"bytes,3,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	// The date window as unix timestamps, 0 falls back to the start or the end of the campaign
+	StartTimestamp       int64    `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp         int64    `protobuf:"varint,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFromUploadersRequest) Reset()         { *m = ImportFromUploadersRequest{} }
+func (m *ImportFromUploadersRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportFromUploadersRequest) ProtoMessage()    {}
+func (*ImportFromUploadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{7}
+}
+
+func (m *ImportFromUploadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFromUploadersRequest.Unmarshal(m, b)
+}
+func (m *ImportFromUploadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFromUploadersRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportFromUploadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFromUploadersRequest.Merge(m, src)
+}
+func (m *ImportFromUploadersRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportFromUploadersRequest.Size(m)
+}
+func (m *ImportFromUploadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFromUploadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFromUploadersRequest proto.InternalMessageInfo
+
+func (m *ImportFromUploadersRequest) GetRoundId() string {
+	if m != nil {
+		return m.RoundId
+	}
+	return ""
+}
+
+func (m *ImportFromUploadersRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ImportFromUploadersRequest) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+func (m *ImportFromUploadersRequest) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
+}
+
+func (m *ImportFromUploadersRequest) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
+}
+
+type ImportFromWikipediaRequest struct {
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The language code of the Wikipedia (e.g. en, bn)
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// If empty, the articles created within the date window are imported
+	Titles []string `protobuf:"bytes,4,rep,name=titles,proto3" json:"titles,omitempty"`
+	// The date window as unix timestamps, 0 falls back to the start or the end of the campaign
+	StartTimestamp       int64    `protobuf:"varint,5,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp         int64    `protobuf:"varint,6,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFromWikipediaRequest) Reset()         { *m = ImportFromWikipediaRequest{} }
+func (m *ImportFromWikipediaRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportFromWikipediaRequest) ProtoMessage()    {}
+func (*ImportFromWikipediaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{8}
+}
+
+func (m *ImportFromWikipediaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFromWikipediaRequest.Unmarshal(m, b)
+}
+func (m *ImportFromWikipediaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFromWikipediaRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportFromWikipediaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFromWikipediaRequest.Merge(m, src)
+}
+func (m *ImportFromWikipediaRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportFromWikipediaRequest.Size(m)
+}
+func (m *ImportFromWikipediaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFromWikipediaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFromWikipediaRequest proto.InternalMessageInfo
+
+func (m *ImportFromWikipediaRequest) GetRoundId() string {
+	if m != nil {
+		return m.RoundId
+	}
+	return ""
+}
+
+func (m *ImportFromWikipediaRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ImportFromWikipediaRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ImportFromWikipediaRequest) GetTitles() []string {
+	if m != nil {
+		return m.Titles
+	}
+	return nil
+}
+
+func (m *ImportFromWikipediaRequest) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
+}
+
+func (m *ImportFromWikipediaRequest) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
+}
+
 type ImportResponse struct {
 	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	RoundId              string   `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
@@ -348,7 +640,7 @@ func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
 func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()    {}
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d916c8da5836c2, []int{5}
+	return fileDescriptor_79d916c8da5836c2, []int{9}
 }
 
 func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
@@ -398,7 +690,7 @@ func (m *DistributeWithRoundRobinRequest) Reset()         { *m = DistributeWithR
 func (m *DistributeWithRoundRobinRequest) String() string { return proto.CompactTextString(m) }
 func (*DistributeWithRoundRobinRequest) ProtoMessage()    {}
 func (*DistributeWithRoundRobinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d916c8da5836c2, []int{6}
+	return fileDescriptor_79d916c8da5836c2, []int{10}
 }
 
 func (m *DistributeWithRoundRobinRequest) XXX_Unmarshal(b []byte) error {
@@ -465,7 +757,7 @@ func (m *DistributeWithRoundRobinResponse) Reset()         { *m = DistributeWith
 func (m *DistributeWithRoundRobinResponse) String() string { return proto.CompactTextString(m) }
 func (*DistributeWithRoundRobinResponse) ProtoMessage()    {}
 func (*DistributeWithRoundRobinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d916c8da5836c2, []int{7}
+	return fileDescriptor_79d916c8da5836c2, []int{11}
 }
 
 func (m *DistributeWithRoundRobinResponse) XXX_Unmarshal(b []byte) error {
@@ -493,6 +785,309 @@ func (m *DistributeWithRoundRobinResponse) GetTaskId() string {
 	return ""
 }
 
+type DistributeRequest struct {
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The name of the strategy in the registry of the task manager
+	Strategy            string   `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	SourceJuryUsernames []string `protobuf:"bytes,4,rep,name=source_jury_usernames,json=sourceJuryUsernames,proto3" json:"source_jury_usernames,omitempty"`
+	TargetJuryUsernames []string `protobuf:"bytes,5,rep,name=target_jury_usernames,json=targetJuryUsernames,proto3" json:"target_jury_usernames,omitempty"`
+	// Strategy specific parameters, e.g. `defaultCapacity` of the capacity-weighted strategy
+	Parameters map[string]string `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, the plan is computed and returned without touching the assignments
+	DryRun               bool     `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DistributeRequest) Reset()         { *m = DistributeRequest{} }
+func (m *DistributeRequest) String() string { return proto.CompactTextString(m) }
+func (*DistributeRequest) ProtoMessage()    {}
+func (*DistributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{12}
+}
+
+func (m *DistributeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DistributeRequest.Unmarshal(m, b)
+}
+func (m *DistributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DistributeRequest.Marshal(b, m, deterministic)
+}
+func (m *DistributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributeRequest.Merge(m, src)
+}
+func (m *DistributeRequest) XXX_Size() int {
+	return xxx_messageInfo_DistributeRequest.Size(m)
+}
+func (m *DistributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributeRequest proto.InternalMessageInfo
+
+func (m *DistributeRequest) GetRoundId() string {
+	if m != nil {
+		return m.RoundId
+	}
+	return ""
+}
+
+func (m *DistributeRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *DistributeRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *DistributeRequest) GetSourceJuryUsernames() []string {
+	if m != nil {
+		return m.SourceJuryUsernames
+	}
+	return nil
+}
+
+func (m *DistributeRequest) GetTargetJuryUsernames() []string {
+	if m != nil {
+		return m.TargetJuryUsernames
+	}
+	return nil
+}
+
+func (m *DistributeRequest) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *DistributeRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type DistributeResponse struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The strategy that was actually used
+	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// The outcome of the distribution, only set for the dry runs
+	Plan                 *DistributionPlan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DistributeResponse) Reset()         { *m = DistributeResponse{} }
+func (m *DistributeResponse) String() string { return proto.CompactTextString(m) }
+func (*DistributeResponse) ProtoMessage()    {}
+func (*DistributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{13}
+}
+
+func (m *DistributeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DistributeResponse.Unmarshal(m, b)
+}
+func (m *DistributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DistributeResponse.Marshal(b, m, deterministic)
+}
+func (m *DistributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributeResponse.Merge(m, src)
+}
+func (m *DistributeResponse) XXX_Size() int {
+	return xxx_messageInfo_DistributeResponse.Size(m)
+}
+func (m *DistributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributeResponse proto.InternalMessageInfo
+
+func (m *DistributeResponse) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *DistributeResponse) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *DistributeResponse) GetPlan() *DistributionPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type JuryDistributionPlan struct {
+	RoleId   string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The number of assignments the jury has now
+	CurrentAssignments int32 `protobuf:"varint,3,opt,name=current_assignments,json=currentAssignments,proto3" json:"current_assignments,omitempty"`
+	// The number of assignments the jury would have after the distribution
+	PlannedAssignments   int32    `protobuf:"varint,4,opt,name=planned_assignments,json=plannedAssignments,proto3" json:"planned_assignments,omitempty"`
+	Evaluated            int32    `protobuf:"varint,5,opt,name=evaluated,proto3" json:"evaluated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JuryDistributionPlan) Reset()         { *m = JuryDistributionPlan{} }
+func (m *JuryDistributionPlan) String() string { return proto.CompactTextString(m) }
+func (*JuryDistributionPlan) ProtoMessage()    {}
+func (*JuryDistributionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{14}
+}
+
+func (m *JuryDistributionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JuryDistributionPlan.Unmarshal(m, b)
+}
+func (m *JuryDistributionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JuryDistributionPlan.Marshal(b, m, deterministic)
+}
+func (m *JuryDistributionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JuryDistributionPlan.Merge(m, src)
+}
+func (m *JuryDistributionPlan) XXX_Size() int {
+	return xxx_messageInfo_JuryDistributionPlan.Size(m)
+}
+func (m *JuryDistributionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_JuryDistributionPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JuryDistributionPlan proto.InternalMessageInfo
+
+func (m *JuryDistributionPlan) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *JuryDistributionPlan) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *JuryDistributionPlan) GetCurrentAssignments() int32 {
+	if m != nil {
+		return m.CurrentAssignments
+	}
+	return 0
+}
+
+func (m *JuryDistributionPlan) GetPlannedAssignments() int32 {
+	if m != nil {
+		return m.PlannedAssignments
+	}
+	return 0
+}
+
+func (m *JuryDistributionPlan) GetEvaluated() int32 {
+	if m != nil {
+		return m.Evaluated
+	}
+	return 0
+}
+
+type DistributionPlan struct {
+	Juries           []*JuryDistributionPlan `protobuf:"bytes,1,rep,name=juries,proto3" json:"juries,omitempty"`
+	TotalAssignments int32                   `protobuf:"varint,2,opt,name=total_assignments,json=totalAssignments,proto3" json:"total_assignments,omitempty"`
+	// The assignments that nobody could take
+	UnassignedAssignments int32 `protobuf:"varint,3,opt,name=unassigned_assignments,json=unassignedAssignments,proto3" json:"unassigned_assignments,omitempty"`
+	// The submissions that would still have less assignments than the quorum
+	UnderQuorumSubmissions int32 `protobuf:"varint,4,opt,name=under_quorum_submissions,json=underQuorumSubmissions,proto3" json:"under_quorum_submissions,omitempty"`
+	// The assignments that would go to a jury who declared a conflict of interest
+	ConflictingAssignments int32 `protobuf:"varint,5,opt,name=conflicting_assignments,json=conflictingAssignments,proto3" json:"conflicting_assignments,omitempty"`
+	// The assignments that would go to the submitter or the participant
+	SelfAssignments      int32    `protobuf:"varint,6,opt,name=self_assignments,json=selfAssignments,proto3" json:"self_assignments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DistributionPlan) Reset()         { *m = DistributionPlan{} }
+func (m *DistributionPlan) String() string { return proto.CompactTextString(m) }
+func (*DistributionPlan) ProtoMessage()    {}
+func (*DistributionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d916c8da5836c2, []int{15}
+}
+
+func (m *DistributionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DistributionPlan.Unmarshal(m, b)
+}
+func (m *DistributionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DistributionPlan.Marshal(b, m, deterministic)
+}
+func (m *DistributionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionPlan.Merge(m, src)
+}
+func (m *DistributionPlan) XXX_Size() int {
+	return xxx_messageInfo_DistributionPlan.Size(m)
+}
+func (m *DistributionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionPlan proto.InternalMessageInfo
+
+func (m *DistributionPlan) GetJuries() []*JuryDistributionPlan {
+	if m != nil {
+		return m.Juries
+	}
+	return nil
+}
+
+func (m *DistributionPlan) GetTotalAssignments() int32 {
+	if m != nil {
+		return m.TotalAssignments
+	}
+	return 0
+}
+
+func (m *DistributionPlan) GetUnassignedAssignments() int32 {
+	if m != nil {
+		return m.UnassignedAssignments
+	}
+	return 0
+}
+
+func (m *DistributionPlan) GetUnderQuorumSubmissions() int32 {
+	if m != nil {
+		return m.UnderQuorumSubmissions
+	}
+	return 0
+}
+
+func (m *DistributionPlan) GetConflictingAssignments() int32 {
+	if m != nil {
+		return m.ConflictingAssignments
+	}
+	return 0
+}
+
+func (m *DistributionPlan) GetSelfAssignments() int32 {
+	if m != nil {
+		return m.SelfAssignments
+	}
+	return 0
+}
+
 type UpdateStatisticsRequest struct {
 	SubmissionIds        []string `protobuf:"bytes,1,rep,name=submission_ids,json=submissionIds,proto3" json:"submission_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -504,7 +1099,7 @@ func (m *UpdateStatisticsRequest) Reset()         { *m = UpdateStatisticsRequest
 func (m *UpdateStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateStatisticsRequest) ProtoMessage()    {}
 func (*UpdateStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d916c8da5836c2, []int{8}
+	return fileDescriptor_79d916c8da5836c2, []int{16}
 }
 
 func (m *UpdateStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -542,7 +1137,7 @@ func (m *UpdateStatisticsResponse) Reset()         { *m = UpdateStatisticsRespon
 func (m *UpdateStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateStatisticsResponse) ProtoMessage()    {}
 func (*UpdateStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d916c8da5836c2, []int{9}
+	return fileDescriptor_79d916c8da5836c2, []int{17}
 }
 
 func (m *UpdateStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -569,9 +1164,18 @@ func init() {
 	proto.RegisterType((*ImportFromCSVRequest)(nil), "models.ImportFromCSVRequest")
 	proto.RegisterType((*ImportFromFountainRequest)(nil), "models.ImportFromFountainRequest")
 	proto.RegisterType((*ImportFromCampWizV1Request)(nil), "models.ImportFromCampWizV1Request")
+	proto.RegisterType((*ImportFromPagePileRequest)(nil), "models.ImportFromPagePileRequest")
+	proto.RegisterType((*ImportFromPetScanRequest)(nil), "models.ImportFromPetScanRequest")
+	proto.RegisterType((*ImportFromUploadersRequest)(nil), "models.ImportFromUploadersRequest")
+	proto.RegisterType((*ImportFromWikipediaRequest)(nil), "models.ImportFromWikipediaRequest")
 	proto.RegisterType((*ImportResponse)(nil), "models.ImportResponse")
 	proto.RegisterType((*DistributeWithRoundRobinRequest)(nil), "models.DistributeWithRoundRobinRequest")
 	proto.RegisterType((*DistributeWithRoundRobinResponse)(nil), "models.DistributeWithRoundRobinResponse")
+	proto.RegisterType((*DistributeRequest)(nil), "models.DistributeRequest")
+	proto.RegisterMapType((map[string]string)(nil), "models.DistributeRequest.ParametersEntry")
+	proto.RegisterType((*DistributeResponse)(nil), "models.DistributeResponse")
+	proto.RegisterType((*JuryDistributionPlan)(nil), "models.JuryDistributionPlan")
+	proto.RegisterType((*DistributionPlan)(nil), "models.DistributionPlan")
 	proto.RegisterType((*UpdateStatisticsRequest)(nil), "models.UpdateStatisticsRequest")
 	proto.RegisterType((*UpdateStatisticsResponse)(nil), "models.UpdateStatisticsResponse")
 }
@@ -581,50 +1185,87 @@ func init() {
 }
 
 var fileDescriptor_79d916c8da5836c2 = []byte{
-	// 713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x9b, 0x34, 0x6d, 0x4e, 0x6f, 0xd2, 0x7b, 0xa7, 0xf7, 0xb6, 0x69, 0x7a, 0xa1, 0xc1,
-	0x52, 0x4b, 0xd8, 0xa4, 0x22, 0x2c, 0xbb, 0x41, 0xa4, 0xad, 0x14, 0x16, 0x50, 0x5c, 0xda, 0x0a,
-	0x24, 0x08, 0x13, 0x7b, 0x70, 0x87, 0x66, 0x3c, 0x66, 0x66, 0x5c, 0xd4, 0xee, 0x58, 0xf0, 0x06,
-	0xbc, 0x07, 0x2f, 0xc4, 0x0a, 0xf1, 0x20, 0xc8, 0x1e, 0x3b, 0xb1, 0x9d, 0x98, 0x2c, 0x2a, 0x56,
-	0xf6, 0x9c, 0x9f, 0x6f, 0xbe, 0xf3, 0xf9, 0x9c, 0x63, 0x68, 0x30, 0xee, 0x90, 0x91, 0xdc, 0x53,
-	0x58, 0x5e, 0x32, 0xec, 0x61, 0x97, 0x88, 0x8e, 0x2f, 0xb8, 0xe2, 0xa8, 0xa2, 0x3d, 0xe6, 0x67,
-	0x03, 0x5a, 0x7d, 0xe6, 0x73, 0xa1, 0x8e, 0x04, 0x67, 0x3d, 0xce, 0x18, 0xf7, 0x64, 0x0f, 0x2b,
-	0xe2, 0x72, 0x71, 0x6d, 0x91, 0x8f, 0x01, 0x91, 0x0a, 0x3d, 0x80, 0xbf, 0x6d, 0xed, 0x19, 0xd8,
-	0xb1, 0xab, 0x61, 0xb4, 0x4a, 0xed, 0xaa, 0xb5, 0x6a, 0x67, 0x33, 0xd0, 0x26, 0x2c, 0x0b, 0x1e,
-	0x78, 0xce, 0x80, 0x3a, 0x8d, 0x85, 0x96, 0xd1, 0xae, 0x5a, 0x4b, 0xd1, 0xb9, 0xef, 0xa0, 0x0d,
-	0x58, 0x0a, 0x79, 0x84, 0x9e, 0x52, 0xe4, 0xa9, 0x84, 0xc7, 0xbe, 0x63, 0x7e, 0x35, 0xe0, 0xee,
-	0x84, 0xc3, 0xb1, 0x20, 0x57, 0x94, 0x07, 0xd2, 0x0a, 0xd3, 0x12, 0x06, 0x69, 0x58, 0xa3, 0x10,
-	0x76, 0x21, 0x0d, 0x8b, 0xd6, 0xa1, 0x22, 0x6d, 0x2e, 0x88, 0x6c, 0x94, 0x5a, 0xa5, 0xf6, 0x82,
-	0x15, 0x9f, 0xd0, 0x2e, 0xac, 0x4a, 0x1e, 0x08, 0x9b, 0x0c, 0xc6, 0x90, 0xe5, 0x28, 0xb1, 0xa6,
-	0xcd, 0x96, 0x06, 0x36, 0x7f, 0x18, 0xf0, 0x6f, 0x4a, 0x9a, 0x93, 0xb3, 0x84, 0x4c, 0x13, 0x96,
-	0xdf, 0xd3, 0x11, 0x39, 0xc6, 0xea, 0x22, 0x26, 0x33, 0x3e, 0xa3, 0x0e, 0x20, 0x19, 0x0c, 0x19,
-	0x95, 0x92, 0x72, 0xaf, 0xef, 0xf4, 0xf8, 0x28, 0x60, 0x5e, 0x4c, 0x6c, 0x86, 0x07, 0x99, 0xf0,
-	0x97, 0x8f, 0x5d, 0x32, 0x8e, 0xd4, 0xca, 0x64, 0x6c, 0x68, 0x17, 0xea, 0x21, 0xfe, 0x33, 0xcc,
-	0x48, 0x1c, 0xa5, 0xf9, 0xe6, 0xac, 0x19, 0x91, 0x16, 0x0b, 0x45, 0xaa, 0x64, 0xb4, 0xb7, 0x61,
-	0x73, 0x52, 0xe3, 0x11, 0x0f, 0x3c, 0x85, 0xa9, 0x77, 0x1b, 0xd5, 0x11, 0x94, 0x6d, 0xee, 0x90,
-	0xb8, 0x90, 0xe8, 0xdd, 0xfc, 0x62, 0x40, 0x33, 0xa5, 0x24, 0x66, 0xfe, 0x39, 0xbd, 0x39, 0x7b,
-	0x98, 0x5c, 0x83, 0xa0, 0xec, 0x4f, 0xb4, 0x8c, 0xde, 0x7f, 0xd7, 0x47, 0xdb, 0xb0, 0x62, 0x63,
-	0xe6, 0x63, 0xea, 0x7a, 0x49, 0x2f, 0x2d, 0x5a, 0x90, 0x98, 0xb2, 0xdc, 0xca, 0x99, 0x62, 0x0f,
-	0xa0, 0xae, 0x69, 0x58, 0x44, 0xfa, 0xdc, 0x93, 0x24, 0x1d, 0x6a, 0x64, 0xca, 0x28, 0xbe, 0xdf,
-	0xfc, 0x69, 0xc0, 0xf6, 0x01, 0x95, 0x4a, 0xd0, 0x61, 0xa0, 0xc8, 0x39, 0x55, 0x17, 0xba, 0x55,
-	0xf9, 0xf0, 0x76, 0xca, 0xed, 0x40, 0xfd, 0x43, 0x20, 0xae, 0x07, 0x81, 0x24, 0xc2, 0xc3, 0x2c,
-	0xee, 0xdb, 0xaa, 0x55, 0x0b, 0xad, 0xa7, 0x89, 0x11, 0x75, 0xe1, 0xbf, 0xb8, 0x7d, 0x73, 0xd1,
-	0xe5, 0x28, 0x7a, 0x4d, 0x3b, 0x9f, 0xe6, 0x73, 0x14, 0x16, 0x2e, 0x51, 0xf9, 0x9c, 0x45, 0x9d,
-	0xa3, 0x9d, 0x99, 0x1c, 0x73, 0x1f, 0x5a, 0xc5, 0x55, 0xce, 0x91, 0xcf, 0x7c, 0x0c, 0x1b, 0xa7,
-	0xbe, 0x83, 0x15, 0x39, 0x51, 0x58, 0x51, 0xa9, 0xa8, 0x2d, 0x13, 0x69, 0x76, 0xa0, 0x3e, 0x99,
-	0x83, 0x01, 0x75, 0x64, 0xbc, 0x4a, 0x6a, 0xe9, 0xe9, 0x90, 0x66, 0x13, 0x1a, 0xd3, 0x08, 0xfa,
-	0xda, 0xee, 0xb7, 0x12, 0x2c, 0xeb, 0x0f, 0x49, 0x04, 0x7a, 0x93, 0xee, 0xe0, 0xdc, 0x02, 0x43,
-	0xed, 0x8e, 0xde, 0x73, 0x9d, 0x79, 0x3b, 0xae, 0xb9, 0x9e, 0x8d, 0x1c, 0x97, 0xf8, 0x0a, 0x36,
-	0x0a, 0x76, 0x13, 0xda, 0x9d, 0x06, 0x9f, 0xb5, 0xbc, 0x0a, 0xa1, 0x0f, 0xa1, 0x96, 0xd9, 0x2f,
-	0xe8, 0xff, 0x19, 0x6c, 0xc7, 0x6b, 0xa7, 0x10, 0xe6, 0x39, 0xa0, 0xe9, 0x11, 0x46, 0xf7, 0xa6,
-	0xb1, 0x72, 0xe3, 0x5d, 0x08, 0xf8, 0x02, 0xd6, 0x66, 0x4c, 0x2b, 0x32, 0x67, 0xb0, 0xcb, 0x8d,
-	0x72, 0x11, 0x64, 0xf7, 0xbb, 0x01, 0x2b, 0xe3, 0x6e, 0xe2, 0x02, 0x31, 0x68, 0x14, 0x35, 0x17,
-	0xba, 0x9f, 0x60, 0xcc, 0x19, 0xb2, 0x66, 0x7b, 0x7e, 0x60, 0x5c, 0xd1, 0x5b, 0xa8, 0x5a, 0xd8,
-	0x73, 0x38, 0xa3, 0x37, 0xe4, 0x0f, 0xe0, 0x77, 0x03, 0xf8, 0x67, 0xd2, 0xa6, 0xba, 0x6d, 0x05,
-	0x7a, 0x07, 0x5b, 0x2f, 0x05, 0x75, 0x5d, 0x22, 0x0e, 0xaf, 0xf0, 0x28, 0xc0, 0x8a, 0x72, 0xef,
-	0x24, 0xfc, 0x05, 0xf5, 0xc2, 0xef, 0x80, 0xb6, 0x13, 0xf4, 0x82, 0x41, 0x69, 0xb6, 0x8a, 0x03,
-	0xf4, 0xb5, 0x4f, 0xee, 0xbc, 0xde, 0xf2, 0xf8, 0x25, 0x1d, 0xee, 0x85, 0xcb, 0xef, 0x13, 0xbd,
-	0xd9, 0xd3, 0x09, 0xfb, 0xfa, 0x31, 0xac, 0x44, 0xbf, 0xfa, 0x47, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x36, 0x96, 0x4c, 0xdc, 0x06, 0x08, 0x00, 0x00,
+	// 1307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6e, 0xdc, 0xc4,
+	0x1b, 0x97, 0xf7, 0xd4, 0xec, 0x97, 0xe6, 0x34, 0x69, 0x13, 0x67, 0x9b, 0xff, 0x3f, 0x8b, 0x51,
+	0xdb, 0xad, 0x40, 0x89, 0x08, 0x20, 0x2a, 0x2a, 0x24, 0x20, 0x6d, 0xa5, 0x54, 0x02, 0x52, 0xa7,
+	0x07, 0x81, 0x04, 0xcb, 0xc4, 0x9e, 0x6e, 0xa7, 0xb1, 0x67, 0xdc, 0x99, 0x71, 0xcb, 0x56, 0x5c,
+	0x70, 0xc3, 0x1b, 0xf0, 0x02, 0xbc, 0x07, 0x0f, 0x80, 0xe0, 0x8e, 0x47, 0x40, 0x5c, 0x73, 0xc7,
+	0x3d, 0xb2, 0xc7, 0xe7, 0xb5, 0x49, 0xa4, 0x15, 0x5c, 0xc5, 0xf3, 0x1d, 0x7f, 0xdf, 0xcf, 0xe3,
+	0x99, 0xdf, 0x06, 0x4c, 0x9f, 0xbb, 0xc4, 0x93, 0x7b, 0x0a, 0xcb, 0x53, 0x1f, 0x33, 0x3c, 0x21,
+	0x62, 0x37, 0x10, 0x5c, 0x71, 0xd4, 0xd3, 0x1e, 0xeb, 0x67, 0x03, 0x86, 0x87, 0x7e, 0xc0, 0x85,
+	0xba, 0x2b, 0xb8, 0x7f, 0xc0, 0x7d, 0x9f, 0x33, 0x79, 0x80, 0x15, 0x99, 0x70, 0x31, 0xb5, 0xc9,
+	0xf3, 0x90, 0x48, 0x85, 0x6e, 0xc0, 0xaa, 0xa3, 0x3d, 0x63, 0x27, 0x71, 0x99, 0xc6, 0xb0, 0x3d,
+	0xea, 0xdb, 0x2b, 0x4e, 0x39, 0x03, 0x6d, 0xc1, 0x82, 0xe0, 0x21, 0x73, 0xc7, 0xd4, 0x35, 0x5b,
+	0x43, 0x63, 0xd4, 0xb7, 0x2f, 0xc4, 0xeb, 0x43, 0x17, 0x6d, 0xc2, 0x85, 0x08, 0x47, 0xe4, 0x69,
+	0xc7, 0x9e, 0x5e, 0xb4, 0x3c, 0x74, 0xd1, 0x25, 0xe8, 0xba, 0x24, 0x50, 0x4f, 0xcd, 0xce, 0xd0,
+	0x18, 0x75, 0x6d, 0xbd, 0x40, 0x7b, 0xb0, 0x4e, 0xbe, 0x71, 0xbc, 0xd0, 0x25, 0x6e, 0xda, 0x95,
+	0x12, 0x69, 0x76, 0xe3, 0xbe, 0x28, 0x75, 0x1d, 0x64, 0x1e, 0xeb, 0x07, 0x03, 0xfe, 0x9f, 0x8f,
+	0x72, 0x24, 0xc8, 0x0b, 0xca, 0x43, 0x69, 0x47, 0xdd, 0xd3, 0x41, 0x8a, 0xe8, 0x8c, 0x46, 0x74,
+	0xad, 0x12, 0xba, 0x0d, 0xe8, 0x49, 0x87, 0x0b, 0x22, 0xcd, 0xf6, 0xb0, 0x3d, 0x6a, 0xd9, 0xc9,
+	0x0a, 0x5d, 0x83, 0x15, 0xc9, 0x43, 0xe1, 0x90, 0x71, 0x56, 0xb2, 0x13, 0x27, 0x2e, 0x69, 0xb3,
+	0xad, 0x0b, 0x5b, 0xbf, 0x1b, 0x70, 0xa9, 0xc0, 0xf0, 0xf1, 0xa3, 0x14, 0xcc, 0x00, 0x16, 0x9e,
+	0x50, 0x8f, 0x1c, 0x61, 0xf5, 0x34, 0x01, 0x93, 0xad, 0xd1, 0x2e, 0x20, 0x19, 0x9e, 0xf8, 0x54,
+	0x4a, 0xca, 0xd9, 0xa1, 0x7b, 0xc0, 0xbd, 0xd0, 0x67, 0x09, 0xb0, 0x1a, 0x0f, 0xb2, 0xe0, 0x62,
+	0x80, 0x27, 0x24, 0x8b, 0xd4, 0x04, 0x97, 0x6c, 0xe8, 0x1a, 0x2c, 0x47, 0xf5, 0x3f, 0xc5, 0x3e,
+	0x49, 0xa2, 0x34, 0xde, 0x8a, 0xb5, 0x44, 0x52, 0xb7, 0x91, 0xa4, 0x5e, 0x91, 0x24, 0xcb, 0x81,
+	0xad, 0x7c, 0xc6, 0xbb, 0x3c, 0x64, 0x0a, 0x53, 0x36, 0x0f, 0xeb, 0x08, 0x3a, 0x0e, 0x77, 0x49,
+	0x32, 0x48, 0xfc, 0x6c, 0x7d, 0x6f, 0xc0, 0xa0, 0xc0, 0x24, 0xf6, 0x83, 0xc7, 0xf4, 0xd5, 0xa3,
+	0xb7, 0xd2, 0x36, 0x08, 0x3a, 0x41, 0xce, 0x65, 0xfc, 0xfc, 0x4f, 0xdb, 0x71, 0x07, 0x16, 0x1d,
+	0xec, 0x07, 0x98, 0x4e, 0x58, 0xba, 0x25, 0xbb, 0x36, 0xa4, 0xa6, 0x32, 0xb6, 0x4e, 0x69, 0xd8,
+	0x6f, 0x8b, 0xc3, 0x1e, 0xe1, 0x09, 0x39, 0xa2, 0x1e, 0x99, 0x67, 0xd8, 0x1d, 0x58, 0x8c, 0xde,
+	0x54, 0x40, 0x3d, 0x92, 0x42, 0x69, 0xdb, 0x90, 0x9a, 0x0e, 0xdd, 0x7b, 0x9d, 0x85, 0xce, 0x6a,
+	0x57, 0x8f, 0x64, 0x7d, 0x67, 0x80, 0x59, 0x68, 0x4f, 0xd4, 0xb1, 0x83, 0xe7, 0xa5, 0x3a, 0xe6,
+	0xad, 0x5d, 0xe0, 0x6d, 0x1b, 0xfa, 0x8a, 0x44, 0x4d, 0xb0, 0x98, 0xc6, 0xd3, 0x2f, 0xd8, 0xb9,
+	0xc1, 0xfa, 0xa9, 0xf4, 0x22, 0x1e, 0x06, 0x1e, 0xc7, 0x2e, 0x11, 0x72, 0x1e, 0x10, 0xdb, 0xd0,
+	0x0f, 0x25, 0x11, 0x0c, 0xfb, 0xc9, 0x87, 0xd6, 0xb7, 0x73, 0x03, 0xba, 0x0e, 0x2b, 0x52, 0x61,
+	0xa1, 0xc6, 0x8a, 0xfa, 0x44, 0x2a, 0xec, 0x07, 0x31, 0xa8, 0xb6, 0xbd, 0x1c, 0x9b, 0x1f, 0xa4,
+	0x56, 0xf4, 0x3a, 0x2c, 0x11, 0xe6, 0x16, 0xc2, 0xba, 0x71, 0xd8, 0x45, 0xc2, 0xdc, 0x2c, 0xc8,
+	0xfa, 0xad, 0x04, 0xff, 0x31, 0x3d, 0xa5, 0x01, 0x71, 0x29, 0x9e, 0x07, 0xfe, 0x00, 0x16, 0x3c,
+	0xcc, 0x26, 0x21, 0x9e, 0xa4, 0x5b, 0x36, 0x5b, 0x47, 0x07, 0x88, 0xa2, 0xca, 0x23, 0xd2, 0xec,
+	0xc4, 0x73, 0x25, 0xab, 0xba, 0xa1, 0xba, 0xe7, 0x1b, 0xaa, 0x57, 0x33, 0xd4, 0x6d, 0x58, 0xd6,
+	0x33, 0xd9, 0x44, 0x06, 0x9c, 0x49, 0x52, 0x04, 0x6b, 0x94, 0xc0, 0x36, 0x7f, 0x14, 0xd6, 0x1f,
+	0x06, 0xec, 0xdc, 0xa6, 0x52, 0x09, 0x7a, 0x12, 0x2a, 0xf2, 0x98, 0xaa, 0xa7, 0xfa, 0xfc, 0xe4,
+	0x27, 0xf3, 0x7d, 0xce, 0x57, 0x61, 0xf9, 0x59, 0x28, 0xa6, 0xe3, 0xea, 0x3b, 0x5e, 0x8a, 0xac,
+	0x0f, 0xb3, 0xf7, 0xbc, 0x0f, 0x97, 0x93, 0x33, 0xb5, 0x12, 0xad, 0x99, 0x5b, 0xd7, 0xce, 0x7b,
+	0xd5, 0x1c, 0x85, 0xc5, 0x84, 0xa8, 0x6a, 0x8e, 0xbe, 0x29, 0xd6, 0xb5, 0xb3, 0x94, 0x63, 0xdd,
+	0x82, 0x61, 0xf3, 0x94, 0x67, 0xd0, 0x67, 0xfd, 0xd9, 0x82, 0xb5, 0x3c, 0x7b, 0xce, 0x5d, 0x23,
+	0x95, 0x88, 0x6e, 0xb0, 0x69, 0xba, 0x6b, 0xd2, 0xf5, 0x7f, 0x45, 0x05, 0x3a, 0x04, 0x08, 0xb0,
+	0xc0, 0x3e, 0x51, 0x44, 0x48, 0xb3, 0x37, 0x6c, 0x8f, 0x16, 0xf7, 0x6f, 0xec, 0x6a, 0x75, 0xb0,
+	0x3b, 0x33, 0xe6, 0xee, 0x51, 0x16, 0x7b, 0x87, 0x29, 0x31, 0xb5, 0x0b, 0xc9, 0xd1, 0x9c, 0xae,
+	0x98, 0x8e, 0x45, 0xc8, 0xcc, 0x0b, 0xf1, 0x91, 0xd1, 0x73, 0xc5, 0xd4, 0x0e, 0xd9, 0xe0, 0x03,
+	0x58, 0xa9, 0xe4, 0xa1, 0x55, 0x68, 0x9f, 0x92, 0x69, 0xc2, 0x54, 0xf4, 0x18, 0xa9, 0x80, 0x17,
+	0xd8, 0x0b, 0x49, 0xc2, 0x91, 0x5e, 0xbc, 0xdf, 0xba, 0x69, 0x58, 0x2f, 0x01, 0x15, 0x81, 0x9c,
+	0xb5, 0xbd, 0x8b, 0xac, 0xb6, 0x2a, 0xac, 0xbe, 0x09, 0x9d, 0xc0, 0xc3, 0xfa, 0x7e, 0x5c, 0xdc,
+	0x37, 0x67, 0xe6, 0xa4, 0x9c, 0x1d, 0x79, 0x98, 0xd9, 0x71, 0x94, 0xf5, 0xab, 0x01, 0x97, 0x22,
+	0xb6, 0xaa, 0xee, 0xa8, 0xb7, 0xe0, 0x1e, 0x29, 0xf4, 0x8e, 0x96, 0xba, 0x77, 0xca, 0x7a, 0xda,
+	0x3b, 0x5d, 0x47, 0x82, 0xc6, 0x09, 0x85, 0x20, 0x4c, 0x8d, 0xb1, 0x94, 0x74, 0xc2, 0x7c, 0xc2,
+	0x94, 0x4c, 0x2e, 0x1e, 0x94, 0xb8, 0x3e, 0xca, 0x3d, 0x51, 0x42, 0x04, 0x83, 0x11, 0xb7, 0x94,
+	0xa0, 0x55, 0x12, 0x4a, 0x5c, 0xc5, 0x84, 0x6d, 0xe8, 0x93, 0x88, 0x36, 0xac, 0x88, 0xbe, 0xba,
+	0xbb, 0x76, 0x6e, 0xb0, 0x7e, 0x69, 0xc1, 0xea, 0xcc, 0x24, 0xef, 0x40, 0xef, 0x59, 0x18, 0x0b,
+	0x2b, 0x23, 0x7e, 0xf5, 0xdb, 0x29, 0x25, 0x75, 0x73, 0xdb, 0x49, 0x2c, 0x7a, 0x03, 0xd6, 0x14,
+	0x57, 0xd8, 0x2b, 0xe1, 0x6a, 0xc5, 0x0d, 0x57, 0x63, 0x47, 0x11, 0xd5, 0xbb, 0xb0, 0x11, 0x32,
+	0x1d, 0x48, 0xdc, 0x9a, 0xd1, 0x2f, 0xe7, 0xde, 0x62, 0xda, 0x4d, 0x30, 0x43, 0xe6, 0x12, 0x31,
+	0x7e, 0x1e, 0x72, 0x11, 0xfa, 0xe3, 0x5c, 0xf5, 0xa4, 0x14, 0x6c, 0xc4, 0xfe, 0xfb, 0xb1, 0xfb,
+	0x38, 0xf7, 0xa2, 0xf7, 0x60, 0xd3, 0xe1, 0xec, 0x89, 0x47, 0x1d, 0x45, 0xd9, 0xa4, 0xd4, 0x51,
+	0x93, 0xb2, 0x51, 0x70, 0x17, 0x5b, 0xde, 0x80, 0x55, 0x49, 0xbc, 0x27, 0xa5, 0x8c, 0x5e, 0x9c,
+	0xb1, 0x12, 0xd9, 0x0b, 0xa1, 0xd6, 0x87, 0xb0, 0xf9, 0x30, 0x70, 0xb1, 0x22, 0xc7, 0x0a, 0x2b,
+	0x2a, 0x15, 0x75, 0xb2, 0xeb, 0xef, 0x2a, 0x2c, 0xe7, 0x58, 0xc7, 0xd4, 0x95, 0x89, 0x56, 0x5e,
+	0x2a, 0xea, 0x36, 0x69, 0x0d, 0xc0, 0x9c, 0xad, 0xa0, 0xf7, 0xf6, 0xfe, 0x5f, 0x5d, 0x58, 0xd0,
+	0xa7, 0x39, 0x11, 0xe8, 0x4b, 0xd8, 0x6a, 0x54, 0xe8, 0x68, 0x94, 0xbe, 0xaf, 0xb3, 0x44, 0xfc,
+	0x60, 0xa3, 0x1c, 0x99, 0x7d, 0x47, 0x9f, 0xc3, 0x66, 0x83, 0x6a, 0x46, 0xd7, 0x66, 0x8b, 0xd7,
+	0xc9, 0xea, 0xc6, 0xd2, 0x77, 0x60, 0xa9, 0xa4, 0x7c, 0xd1, 0x76, 0x0d, 0xda, 0xe3, 0x47, 0x67,
+	0x95, 0xf9, 0x0c, 0xd0, 0xac, 0xb8, 0x44, 0xaf, 0xcd, 0xd6, 0xaa, 0x08, 0xcf, 0xc6, 0x82, 0xf7,
+	0x61, 0xbd, 0x46, 0x47, 0x22, 0xab, 0x06, 0x5d, 0x45, 0x64, 0x9e, 0x0f, 0x63, 0xaa, 0x09, 0xeb,
+	0x30, 0x56, 0xf4, 0x62, 0x63, 0xc1, 0x4f, 0x60, 0x6d, 0x46, 0xe5, 0xa1, 0x61, 0x4d, 0xbd, 0x92,
+	0x00, 0x3c, 0xdf, 0xc8, 0x99, 0x62, 0xab, 0x1b, 0xb9, 0x2a, 0xe7, 0xce, 0x57, 0x32, 0x53, 0x51,
+	0x75, 0x25, 0xab, 0x12, 0xab, 0xa9, 0xe4, 0xfe, 0x8f, 0x2d, 0x58, 0xcc, 0x0e, 0x1d, 0x2e, 0x90,
+	0x0f, 0x66, 0xd3, 0x3d, 0x8d, 0xae, 0xcf, 0x5e, 0x52, 0xb5, 0x7a, 0x65, 0x30, 0x3a, 0x3b, 0x30,
+	0x99, 0xe8, 0x2b, 0xe8, 0xdb, 0x98, 0xb9, 0xdc, 0xa7, 0xaf, 0xc8, 0xbf, 0x51, 0xff, 0x00, 0x20,
+	0x8f, 0x41, 0x5b, 0x8d, 0xb7, 0xec, 0x60, 0x50, 0xe7, 0x4a, 0x38, 0x0a, 0x61, 0x2d, 0x3f, 0x31,
+	0xf4, 0x09, 0x22, 0xd0, 0xd7, 0x70, 0xe5, 0x81, 0xa0, 0x93, 0x09, 0x11, 0x77, 0xf4, 0x79, 0x4f,
+	0x39, 0x3b, 0x8e, 0x7e, 0xa7, 0x1e, 0x44, 0x9f, 0x04, 0xda, 0x49, 0xeb, 0x35, 0x9c, 0x59, 0x83,
+	0x61, 0x73, 0x80, 0x6e, 0xfb, 0xf1, 0xff, 0xbe, 0xb8, 0xc2, 0xf8, 0x29, 0x3d, 0xd9, 0x8b, 0x7e,
+	0x21, 0xbd, 0xa4, 0xaf, 0xf6, 0x74, 0xc2, 0x2d, 0xfd, 0xe7, 0xa4, 0x17, 0xff, 0x5b, 0xe1, 0xed,
+	0xbf, 0x07, 0x00, 0xde, 0xd5, 0xa3, 0xf9, 0x72, 0x10, 0x00, 0x00,
 }
@@ -24,6 +24,10 @@ const (
 	Importer_ImportFromCSV_FullMethodName             = "/models.Importer/ImportFromCSV"
 	Importer_ImportFromFountain_FullMethodName        = "/models.Importer/ImportFromFountain"
 	Importer_ImportFromCampWizV1_FullMethodName       = "/models.Importer/ImportFromCampWizV1"
+	Importer_ImportFromPagePile_FullMethodName        = "/models.Importer/ImportFromPagePile"
+	Importer_ImportFromPetScan_FullMethodName         = "/models.Importer/ImportFromPetScan"
+	Importer_ImportFromUploaders_FullMethodName       = "/models.Importer/ImportFromUploaders"
+	Importer_ImportFromWikipedia_FullMethodName       = "/models.Importer/ImportFromWikipedia"
 )
 
 // ImporterClient is the client API for Importer service.
@@ -35,6 +39,14 @@ type ImporterClient interface {
 	ImportFromCSV(ctx context.Context, in *ImportFromCSVRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	ImportFromFountain(ctx context.Context, in *ImportFromFountainRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	ImportFromCampWizV1(ctx context.Context, in *ImportFromCampWizV1Request, opts ...grpc.CallOption) (*ImportResponse, error)
+	// ImportFromPagePile imports the files listed in a PagePile dump
+	ImportFromPagePile(ctx context.Context, in *ImportFromPagePileRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	// ImportFromPetScan imports the files listed in a PetScan JSON result
+	ImportFromPetScan(ctx context.Context, in *ImportFromPetScanRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	// ImportFromUploaders imports the files uploaded by the users within the date window
+	ImportFromUploaders(ctx context.Context, in *ImportFromUploadersRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	// ImportFromWikipedia imports the articles of a Wikipedia edited within the date window
+	ImportFromWikipedia(ctx context.Context, in *ImportFromWikipediaRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 }
 
 type importerClient struct {
@@ -95,6 +107,46 @@ func (c *importerClient) ImportFromCampWizV1(ctx context.Context, in *ImportFrom
 	return out, nil
 }
 
+func (c *importerClient) ImportFromPagePile(ctx context.Context, in *ImportFromPagePileRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, Importer_ImportFromPagePile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importerClient) ImportFromPetScan(ctx context.Context, in *ImportFromPetScanRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, Importer_ImportFromPetScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importerClient) ImportFromUploaders(ctx context.Context, in *ImportFromUploadersRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, Importer_ImportFromUploaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importerClient) ImportFromWikipedia(ctx context.Context, in *ImportFromWikipediaRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, Importer_ImportFromWikipedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImporterServer is the server API for Importer service.
 // All implementations must embed UnimplementedImporterServer
 // for forward compatibility.
@@ -104,6 +156,14 @@ type ImporterServer interface {
 	ImportFromCSV(context.Context, *ImportFromCSVRequest) (*ImportResponse, error)
 	ImportFromFountain(context.Context, *ImportFromFountainRequest) (*ImportResponse, error)
 	ImportFromCampWizV1(context.Context, *ImportFromCampWizV1Request) (*ImportResponse, error)
+	// ImportFromPagePile imports the files listed in a PagePile dump
+	ImportFromPagePile(context.Context, *ImportFromPagePileRequest) (*ImportResponse, error)
+	// ImportFromPetScan imports the files listed in a PetScan JSON result
+	ImportFromPetScan(context.Context, *ImportFromPetScanRequest) (*ImportResponse, error)
+	// ImportFromUploaders imports the files uploaded by the users within the date window
+	ImportFromUploaders(context.Context, *ImportFromUploadersRequest) (*ImportResponse, error)
+	// ImportFromWikipedia imports the articles of a Wikipedia edited within the date window
+	ImportFromWikipedia(context.Context, *ImportFromWikipediaRequest) (*ImportResponse, error)
 	mustEmbedUnimplementedImporterServer()
 }
 
@@ -129,6 +189,18 @@ func (UnimplementedImporterServer) ImportFromFountain(context.Context, *ImportFr
 func (UnimplementedImporterServer) ImportFromCampWizV1(context.Context, *ImportFromCampWizV1Request) (*ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportFromCampWizV1 not implemented")
 }
+func (UnimplementedImporterServer) ImportFromPagePile(context.Context, *ImportFromPagePileRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromPagePile not implemented")
+}
+func (UnimplementedImporterServer) ImportFromPetScan(context.Context, *ImportFromPetScanRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromPetScan not implemented")
+}
+func (UnimplementedImporterServer) ImportFromUploaders(context.Context, *ImportFromUploadersRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromUploaders not implemented")
+}
+func (UnimplementedImporterServer) ImportFromWikipedia(context.Context, *ImportFromWikipediaRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromWikipedia not implemented")
+}
 func (UnimplementedImporterServer) mustEmbedUnimplementedImporterServer() {}
 func (UnimplementedImporterServer) testEmbeddedByValue()                  {}
 
@@ -240,6 +312,78 @@ func _Importer_ImportFromCampWizV1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Importer_ImportFromPagePile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromPagePileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImporterServer).ImportFromPagePile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Importer_ImportFromPagePile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImporterServer).ImportFromPagePile(ctx, req.(*ImportFromPagePileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Importer_ImportFromPetScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromPetScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImporterServer).ImportFromPetScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Importer_ImportFromPetScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImporterServer).ImportFromPetScan(ctx, req.(*ImportFromPetScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Importer_ImportFromUploaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromUploadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImporterServer).ImportFromUploaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Importer_ImportFromUploaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImporterServer).ImportFromUploaders(ctx, req.(*ImportFromUploadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Importer_ImportFromWikipedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromWikipediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImporterServer).ImportFromWikipedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Importer_ImportFromWikipedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImporterServer).ImportFromWikipedia(ctx, req.(*ImportFromWikipediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Importer_ServiceDesc is the grpc.ServiceDesc for Importer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +411,22 @@ var Importer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportFromCampWizV1",
 			Handler:    _Importer_ImportFromCampWizV1_Handler,
 		},
+		{
+			MethodName: "ImportFromPagePile",
+			Handler:    _Importer_ImportFromPagePile_Handler,
+		},
+		{
+			MethodName: "ImportFromPetScan",
+			Handler:    _Importer_ImportFromPetScan_Handler,
+		},
+		{
+			MethodName: "ImportFromUploaders",
+			Handler:    _Importer_ImportFromUploaders_Handler,
+		},
+		{
+			MethodName: "ImportFromWikipedia",
+			Handler:    _Importer_ImportFromWikipedia_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "models/taskmanager.proto",
@@ -275,6 +435,7 @@ var Importer_ServiceDesc = grpc.ServiceDesc{
 const (
 	Distributor_DistributeWithRoundRobin_FullMethodName = "/models.Distributor/DistributeWithRoundRobin"
 	Distributor_Randomize_FullMethodName                = "/models.Distributor/Randomize"
+	Distributor_Distribute_FullMethodName               = "/models.Distributor/Distribute"
 )
 
 // DistributorClient is the client API for Distributor service.
@@ -285,6 +446,8 @@ type DistributorClient interface {
 	DistributeWithRoundRobin(ctx context.Context, in *DistributeWithRoundRobinRequest, opts ...grpc.CallOption) (*DistributeWithRoundRobinResponse, error)
 	// Randomize the assignments to different juries so that the distribution is random
 	Randomize(ctx context.Context, in *DistributeWithRoundRobinRequest, opts ...grpc.CallOption) (*DistributeWithRoundRobinResponse, error)
+	// Distribute distributes the assignments with the named strategy (the configured default if the strategy is empty)
+	Distribute(ctx context.Context, in *DistributeRequest, opts ...grpc.CallOption) (*DistributeResponse, error)
 }
 
 type distributorClient struct {
@@ -315,6 +478,16 @@ func (c *distributorClient) Randomize(ctx context.Context, in *DistributeWithRou
 	return out, nil
 }
 
+func (c *distributorClient) Distribute(ctx context.Context, in *DistributeRequest, opts ...grpc.CallOption) (*DistributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributeResponse)
+	err := c.cc.Invoke(ctx, Distributor_Distribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DistributorServer is the server API for Distributor service.
 // All implementations must embed UnimplementedDistributorServer
 // for forward compatibility.
@@ -323,6 +496,8 @@ type DistributorServer interface {
 	DistributeWithRoundRobin(context.Context, *DistributeWithRoundRobinRequest) (*DistributeWithRoundRobinResponse, error)
 	// Randomize the assignments to different juries so that the distribution is random
 	Randomize(context.Context, *DistributeWithRoundRobinRequest) (*DistributeWithRoundRobinResponse, error)
+	// Distribute distributes the assignments with the named strategy (the configured default if the strategy is empty)
+	Distribute(context.Context, *DistributeRequest) (*DistributeResponse, error)
 	mustEmbedUnimplementedDistributorServer()
 }
 
@@ -339,6 +514,9 @@ func (UnimplementedDistributorServer) DistributeWithRoundRobin(context.Context,
 func (UnimplementedDistributorServer) Randomize(context.Context, *DistributeWithRoundRobinRequest) (*DistributeWithRoundRobinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Randomize not implemented")
 }
+func (UnimplementedDistributorServer) Distribute(context.Context, *DistributeRequest) (*DistributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribute not implemented")
+}
 func (UnimplementedDistributorServer) mustEmbedUnimplementedDistributorServer() {}
 func (UnimplementedDistributorServer) testEmbeddedByValue()                     {}
 
@@ -396,6 +574,24 @@ func _Distributor_Randomize_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Distributor_Distribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistributorServer).Distribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Distributor_Distribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistributorServer).Distribute(ctx, req.(*DistributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Distributor_ServiceDesc is the grpc.ServiceDesc for Distributor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +607,10 @@ var Distributor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Randomize",
 			Handler:    _Distributor_Randomize_Handler,
 		},
+		{
+			MethodName: "Distribute",
+			Handler:    _Distributor_Distribute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "models/taskmanager.proto",
@@ -14,12 +14,14 @@ func main() {
 	})
 	g.ApplyBasic(models.Project{}, models.User{}, models.Campaign{},
 		models.Round{}, models.Task{}, models.Role{}, models.Submission{},
-		models.Evaluation{}, cache.Evaluation{}, models.SubmissionResult{}, models.TaskData{}, models.Category{}, models.Tag{})
+		models.Evaluation{}, cache.Evaluation{}, models.SubmissionResult{}, models.TaskData{}, models.Category{}, models.Tag{},
+		models.RankingPreference{}, models.ConflictOfInterest{})
 	g.ApplyInterface(func(cache.Dirtributor) {}, cache.Evaluation{})
 	g.ApplyInterface(func(models.SubmissionStatisticsFetcher) {}, models.SubmissionStatistics{})
 	g.ApplyInterface(func(models.JuryStatisticsUpdater) {}, models.JuryStatistics{})
 	g.ApplyInterface(func(models.RoundStatisticsFetcher) {}, models.RoundStatistics{}, models.RoundStatisticsView{})
 	g.ApplyInterface(func(models.Evaluator) {}, models.Evaluation{})
 	g.ApplyInterface(func(models.SubmissionFetcher) {}, models.CommonsSubmissionEntry{})
+	g.ApplyInterface(func(models.CommonsCategoryTraverser) {}, models.CommonsCategoryLink{})
 	g.Execute()
 }
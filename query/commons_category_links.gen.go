@@ -0,0 +1,430 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"nokib/campwiz/models"
+)
+
+func newCommonsCategoryLink(db *gorm.DB, opts ...gen.DOOption) commonsCategoryLink {
+	_commonsCategoryLink := commonsCategoryLink{}
+
+	_commonsCategoryLink.commonsCategoryLinkDo.UseDB(db, opts...)
+	_commonsCategoryLink.commonsCategoryLinkDo.UseModel(&models.CommonsCategoryLink{})
+
+	tableName := _commonsCategoryLink.commonsCategoryLinkDo.TableName()
+	_commonsCategoryLink.ALL = field.NewAsterisk(tableName)
+	_commonsCategoryLink.ClFrom = field.NewUint64(tableName, "cl_from")
+	_commonsCategoryLink.ClTo = field.NewString(tableName, "cl_to")
+	_commonsCategoryLink.PageTitle = field.NewString(tableName, "page_title")
+
+	_commonsCategoryLink.fillFieldMap()
+
+	return _commonsCategoryLink
+}
+
+type commonsCategoryLink struct {
+	commonsCategoryLinkDo
+
+	ALL       field.Asterisk
+	ClFrom    field.Uint64
+	ClTo      field.String
+	PageTitle field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (c commonsCategoryLink) Table(newTableName string) *commonsCategoryLink {
+	c.commonsCategoryLinkDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c commonsCategoryLink) As(alias string) *commonsCategoryLink {
+	c.commonsCategoryLinkDo.DO = *(c.commonsCategoryLinkDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *commonsCategoryLink) updateTableName(table string) *commonsCategoryLink {
+	c.ALL = field.NewAsterisk(table)
+	c.ClFrom = field.NewUint64(table, "cl_from")
+	c.ClTo = field.NewString(table, "cl_to")
+	c.PageTitle = field.NewString(table, "page_title")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *commonsCategoryLink) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *commonsCategoryLink) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 3)
+	c.fieldMap["cl_from"] = c.ClFrom
+	c.fieldMap["cl_to"] = c.ClTo
+	c.fieldMap["page_title"] = c.PageTitle
+}
+
+func (c commonsCategoryLink) clone(db *gorm.DB) commonsCategoryLink {
+	c.commonsCategoryLinkDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c commonsCategoryLink) replaceDB(db *gorm.DB) commonsCategoryLink {
+	c.commonsCategoryLinkDo.ReplaceDB(db)
+	return c
+}
+
+type commonsCategoryLinkDo struct{ gen.DO }
+
+type ICommonsCategoryLinkDo interface {
+	gen.SubQuery
+	Debug() ICommonsCategoryLinkDo
+	WithContext(ctx context.Context) ICommonsCategoryLinkDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICommonsCategoryLinkDo
+	WriteDB() ICommonsCategoryLinkDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICommonsCategoryLinkDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICommonsCategoryLinkDo
+	Not(conds ...gen.Condition) ICommonsCategoryLinkDo
+	Or(conds ...gen.Condition) ICommonsCategoryLinkDo
+	Select(conds ...field.Expr) ICommonsCategoryLinkDo
+	Where(conds ...gen.Condition) ICommonsCategoryLinkDo
+	Order(conds ...field.Expr) ICommonsCategoryLinkDo
+	Distinct(cols ...field.Expr) ICommonsCategoryLinkDo
+	Omit(cols ...field.Expr) ICommonsCategoryLinkDo
+	Join(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo
+	Group(cols ...field.Expr) ICommonsCategoryLinkDo
+	Having(conds ...gen.Condition) ICommonsCategoryLinkDo
+	Limit(limit int) ICommonsCategoryLinkDo
+	Offset(offset int) ICommonsCategoryLinkDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICommonsCategoryLinkDo
+	Unscoped() ICommonsCategoryLinkDo
+	Create(values ...*models.CommonsCategoryLink) error
+	CreateInBatches(values []*models.CommonsCategoryLink, batchSize int) error
+	Save(values ...*models.CommonsCategoryLink) error
+	First() (*models.CommonsCategoryLink, error)
+	Take() (*models.CommonsCategoryLink, error)
+	Last() (*models.CommonsCategoryLink, error)
+	Find() ([]*models.CommonsCategoryLink, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommonsCategoryLink, err error)
+	FindInBatches(result *[]*models.CommonsCategoryLink, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.CommonsCategoryLink) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICommonsCategoryLinkDo
+	Assign(attrs ...field.AssignExpr) ICommonsCategoryLinkDo
+	Joins(fields ...field.RelationField) ICommonsCategoryLinkDo
+	Preload(fields ...field.RelationField) ICommonsCategoryLinkDo
+	FirstOrInit() (*models.CommonsCategoryLink, error)
+	FirstOrCreate() (*models.CommonsCategoryLink, error)
+	FindByPage(offset int, limit int) (result []*models.CommonsCategoryLink, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICommonsCategoryLinkDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+
+	FetchSubcategories(categories []string) (result []models.CommonsCategoryLink, err error)
+	FetchCategoryMembership(categories []string, pageIds []uint64) (result []models.CommonsCategoryLink, err error)
+}
+
+// FetchSubcategories fetches the direct subcategories of the categories, the title of a subcategory is in PageTitle.
+//
+// SELECT cl_from, cl_to, page_title FROM categorylinks JOIN page ON cl_from = page_id WHERE cl_to IN (@categories) AND cl_type = 'subcat' AND page_namespace = 14
+func (c commonsCategoryLinkDo) FetchSubcategories(categories []string) (result []models.CommonsCategoryLink, err error) {
+	var params []interface{}
+
+	var generateSQL strings.Builder
+	params = append(params, categories)
+	generateSQL.WriteString("SELECT cl_from, cl_to, page_title FROM categorylinks JOIN page ON cl_from = page_id WHERE cl_to IN (?) AND cl_type = 'subcat' AND page_namespace = 14 ")
+
+	var executeSQL *gorm.DB
+	executeSQL = c.UnderlyingDB().Raw(generateSQL.String(), params...).Find(&result) // ignore_security_alert
+	err = executeSQL.Error
+
+	return
+}
+
+// FetchCategoryMembership fetches the pages which are directly in any of the categories
+//
+// SELECT cl_from, cl_to FROM categorylinks WHERE cl_to IN (@categories) AND cl_from IN (@pageIds)
+func (c commonsCategoryLinkDo) FetchCategoryMembership(categories []string, pageIds []uint64) (result []models.CommonsCategoryLink, err error) {
+	var params []interface{}
+
+	var generateSQL strings.Builder
+	params = append(params, categories)
+	params = append(params, pageIds)
+	generateSQL.WriteString("SELECT cl_from, cl_to FROM categorylinks WHERE cl_to IN (?) AND cl_from IN (?) ")
+
+	var executeSQL *gorm.DB
+	executeSQL = c.UnderlyingDB().Raw(generateSQL.String(), params...).Find(&result) // ignore_security_alert
+	err = executeSQL.Error
+
+	return
+}
+
+func (c commonsCategoryLinkDo) Debug() ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c commonsCategoryLinkDo) WithContext(ctx context.Context) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c commonsCategoryLinkDo) ReadDB() ICommonsCategoryLinkDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c commonsCategoryLinkDo) WriteDB() ICommonsCategoryLinkDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c commonsCategoryLinkDo) Session(config *gorm.Session) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c commonsCategoryLinkDo) Clauses(conds ...clause.Expression) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c commonsCategoryLinkDo) Returning(value interface{}, columns ...string) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c commonsCategoryLinkDo) Not(conds ...gen.Condition) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c commonsCategoryLinkDo) Or(conds ...gen.Condition) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c commonsCategoryLinkDo) Select(conds ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c commonsCategoryLinkDo) Where(conds ...gen.Condition) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c commonsCategoryLinkDo) Order(conds ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c commonsCategoryLinkDo) Distinct(cols ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c commonsCategoryLinkDo) Omit(cols ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c commonsCategoryLinkDo) Join(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c commonsCategoryLinkDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c commonsCategoryLinkDo) RightJoin(table schema.Tabler, on ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c commonsCategoryLinkDo) Group(cols ...field.Expr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c commonsCategoryLinkDo) Having(conds ...gen.Condition) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c commonsCategoryLinkDo) Limit(limit int) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c commonsCategoryLinkDo) Offset(offset int) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c commonsCategoryLinkDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c commonsCategoryLinkDo) Unscoped() ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c commonsCategoryLinkDo) Create(values ...*models.CommonsCategoryLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c commonsCategoryLinkDo) CreateInBatches(values []*models.CommonsCategoryLink, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c commonsCategoryLinkDo) Save(values ...*models.CommonsCategoryLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c commonsCategoryLinkDo) First() (*models.CommonsCategoryLink, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommonsCategoryLink), nil
+	}
+}
+
+func (c commonsCategoryLinkDo) Take() (*models.CommonsCategoryLink, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommonsCategoryLink), nil
+	}
+}
+
+func (c commonsCategoryLinkDo) Last() (*models.CommonsCategoryLink, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommonsCategoryLink), nil
+	}
+}
+
+func (c commonsCategoryLinkDo) Find() ([]*models.CommonsCategoryLink, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.CommonsCategoryLink), err
+}
+
+func (c commonsCategoryLinkDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommonsCategoryLink, err error) {
+	buf := make([]*models.CommonsCategoryLink, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c commonsCategoryLinkDo) FindInBatches(result *[]*models.CommonsCategoryLink, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c commonsCategoryLinkDo) Attrs(attrs ...field.AssignExpr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c commonsCategoryLinkDo) Assign(attrs ...field.AssignExpr) ICommonsCategoryLinkDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c commonsCategoryLinkDo) Joins(fields ...field.RelationField) ICommonsCategoryLinkDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c commonsCategoryLinkDo) Preload(fields ...field.RelationField) ICommonsCategoryLinkDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c commonsCategoryLinkDo) FirstOrInit() (*models.CommonsCategoryLink, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommonsCategoryLink), nil
+	}
+}
+
+func (c commonsCategoryLinkDo) FirstOrCreate() (*models.CommonsCategoryLink, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommonsCategoryLink), nil
+	}
+}
+
+func (c commonsCategoryLinkDo) FindByPage(offset int, limit int) (result []*models.CommonsCategoryLink, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c commonsCategoryLinkDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c commonsCategoryLinkDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c commonsCategoryLinkDo) Delete(models ...*models.CommonsCategoryLink) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *commonsCategoryLinkDo) withDO(do gen.Dao) *commonsCategoryLinkDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
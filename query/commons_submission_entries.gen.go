@@ -183,6 +183,8 @@ type ICommonsSubmissionEntryDo interface {
 	FetchSubmissionsFromCommonsDBByCategoryOld(categoryName string, startPageID uint64, minimumTimestamp uint64, maximumTimestamp uint64, limit int, allowedMediaTypes []string) (result []models.CommonsSubmissionEntry, err error)
 	FetchSubmissionsFromCommonsDBByCategory(categoryName string, startPageID uint64, minimumTimestamp uint64, maximumTimestamp uint64, limit int, allowedMediaTypes []string) (result []models.CommonsSubmissionEntry, err error)
 	FetchSubmissionsFromCommonsDBByPageID(pageids []uint64, limit int) (result []models.CommonsSubmissionEntry, err error)
+	FetchSubmissionsFromCommonsDBByTitle(titles []string, limit int) (result []models.CommonsSubmissionEntry, err error)
+	FetchSubmissionsFromCommonsDBByUploader(usernames []string, startPageID uint64, minimumTimestamp uint64, maximumTimestamp uint64, limit int, allowedMediaTypes []string) (result []models.CommonsSubmissionEntry, err error)
 }
 
 // SLOW OK
@@ -277,6 +279,134 @@ func (c commonsSubmissionEntryDo) FetchSubmissionsFromCommonsDBByPageID(pageids
 	return
 }
 
+// FetchSubmissionsFromCommonsDBByTitle fetches submissions from the Commons database by the file name (without the `File:` prefix).
+//
+// SELECT
+//
+//	page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type
+//
+// FROM
+//
+//	page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes
+//
+// ON
+//
+//	ft_id = file_type AND fr_id=file_latest
+//
+// AND
+//
+//	user_id=actor_user
+//
+// AND
+//
+//	file_name=page_title
+//
+// AND
+//
+//	actor_id=fr_actor
+//
+// WHERE
+//
+//	page_namespace = 6
+//
+// AND
+//
+//	page_title IN (@titles)
+//
+// AND
+//
+//	fr_deleted = false
+//
+// AND
+//
+//	file_deleted=false ORDER BY `page_id` ASC LIMIT @limit;
+func (c commonsSubmissionEntryDo) FetchSubmissionsFromCommonsDBByTitle(titles []string, limit int) (result []models.CommonsSubmissionEntry, err error) {
+	var params []interface{}
+
+	var generateSQL strings.Builder
+	params = append(params, titles)
+	params = append(params, limit)
+	generateSQL.WriteString("SELECT page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type FROM page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes ON ft_id = file_type AND fr_id=file_latest AND user_id=actor_user AND file_name=page_title AND actor_id=fr_actor WHERE page_namespace = 6 AND page_title IN (?) AND fr_deleted = false AND file_deleted=false ORDER BY `page_id` ASC LIMIT ?; ")
+
+	var executeSQL *gorm.DB
+	executeSQL = c.UnderlyingDB().Raw(generateSQL.String(), params...).Find(&result) // ignore_security_alert
+	err = executeSQL.Error
+
+	return
+}
+
+// FetchSubmissionsFromCommonsDBByUploader fetches the files whose latest revision was uploaded by any of the users (user_name, with spaces).
+//
+// SELECT
+//
+//	page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type
+//
+// FROM
+//
+//	page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes
+//
+// ON
+//
+//	ft_id = file_type AND fr_id=file_latest
+//
+// AND
+//
+//	user_id=actor_user
+//
+// AND
+//
+//	file_name=page_title
+//
+// AND
+//
+//	actor_id=fr_actor
+//
+// WHERE
+//
+//	page_namespace = 6
+//
+// AND
+//
+//	user_name IN (@usernames)
+//
+// AND
+//
+//	ft_media_type IN (@allowedMediaTypes)
+//
+// AND
+//
+//	page_id > @startPageID
+//
+// AND
+//
+//	@minimumTimestamp <= fr_timestamp AND fr_timestamp < @maximumTimestamp
+//
+// AND
+//
+//	fr_deleted = false
+//
+// AND
+//
+//	file_deleted=false ORDER BY `page_id` ASC LIMIT @limit;
+func (c commonsSubmissionEntryDo) FetchSubmissionsFromCommonsDBByUploader(usernames []string, startPageID uint64, minimumTimestamp uint64, maximumTimestamp uint64, limit int, allowedMediaTypes []string) (result []models.CommonsSubmissionEntry, err error) {
+	var params []interface{}
+
+	var generateSQL strings.Builder
+	params = append(params, usernames)
+	params = append(params, allowedMediaTypes)
+	params = append(params, startPageID)
+	params = append(params, minimumTimestamp)
+	params = append(params, maximumTimestamp)
+	params = append(params, limit)
+	generateSQL.WriteString("SELECT page_id, page_title, user_name, fr_timestamp, fr_height, fr_width, fr_size, ft_media_type FROM page JOIN file JOIN filerevision JOIN actor JOIN `user` JOIN filetypes ON ft_id = file_type AND fr_id=file_latest AND user_id=actor_user AND file_name=page_title AND actor_id=fr_actor WHERE page_namespace = 6 AND user_name IN (?) AND ft_media_type IN (?) AND page_id > ? AND ? <= fr_timestamp AND fr_timestamp < ? AND fr_deleted = false AND file_deleted=false ORDER BY `page_id` ASC LIMIT ?; ")
+
+	var executeSQL *gorm.DB
+	executeSQL = c.UnderlyingDB().Raw(generateSQL.String(), params...).Find(&result) // ignore_security_alert
+	err = executeSQL.Error
+
+	return
+}
+
 func (c commonsSubmissionEntryDo) Debug() ICommonsSubmissionEntryDo {
 	return c.withDO(c.DO.Debug())
 }
@@ -0,0 +1,789 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"nokib/campwiz/models"
+)
+
+func newConflictOfInterest(db *gorm.DB, opts ...gen.DOOption) conflictOfInterest {
+	_conflictOfInterest := conflictOfInterest{}
+
+	_conflictOfInterest.conflictOfInterestDo.UseDB(db, opts...)
+	_conflictOfInterest.conflictOfInterestDo.UseModel(&models.ConflictOfInterest{})
+
+	tableName := _conflictOfInterest.conflictOfInterestDo.TableName()
+	_conflictOfInterest.ALL = field.NewAsterisk(tableName)
+	_conflictOfInterest.ConflictID = field.NewString(tableName, "conflict_id")
+	_conflictOfInterest.RoleID = field.NewString(tableName, "role_id")
+	_conflictOfInterest.RoundID = field.NewString(tableName, "round_id")
+	_conflictOfInterest.Type = field.NewString(tableName, "type")
+	_conflictOfInterest.ParticipantID = field.NewString(tableName, "participant_id")
+	_conflictOfInterest.SubmissionID = field.NewString(tableName, "submission_id")
+	_conflictOfInterest.Reason = field.NewString(tableName, "reason")
+	_conflictOfInterest.CreatedAt = field.NewTime(tableName, "created_at")
+	_conflictOfInterest.Role = conflictOfInterestHasOneRole{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Role", "models.Role"),
+		Round: struct {
+			field.RelationField
+			Campaign struct {
+				field.RelationField
+				CreatedBy struct {
+					field.RelationField
+					LeadingProject struct {
+						field.RelationField
+					}
+				}
+				Project struct {
+					field.RelationField
+				}
+				LatestRound struct {
+					field.RelationField
+				}
+				CampaignTags struct {
+					field.RelationField
+					Campaign struct {
+						field.RelationField
+					}
+				}
+				Roles struct {
+					field.RelationField
+				}
+				Rounds struct {
+					field.RelationField
+				}
+			}
+			Creator struct {
+				field.RelationField
+			}
+			DependsOnRound struct {
+				field.RelationField
+			}
+			Roles struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Role.Round", "models.Round"),
+			Campaign: struct {
+				field.RelationField
+				CreatedBy struct {
+					field.RelationField
+					LeadingProject struct {
+						field.RelationField
+					}
+				}
+				Project struct {
+					field.RelationField
+				}
+				LatestRound struct {
+					field.RelationField
+				}
+				CampaignTags struct {
+					field.RelationField
+					Campaign struct {
+						field.RelationField
+					}
+				}
+				Roles struct {
+					field.RelationField
+				}
+				Rounds struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Role.Round.Campaign", "models.Campaign"),
+				CreatedBy: struct {
+					field.RelationField
+					LeadingProject struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.CreatedBy", "models.User"),
+					LeadingProject: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Role.Round.Campaign.CreatedBy.LeadingProject", "models.Project"),
+					},
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.Project", "models.Project"),
+				},
+				LatestRound: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.LatestRound", "models.Round"),
+				},
+				CampaignTags: struct {
+					field.RelationField
+					Campaign struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.CampaignTags", "models.Tag"),
+					Campaign: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Role.Round.Campaign.CampaignTags.Campaign", "models.Campaign"),
+					},
+				},
+				Roles: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.Roles", "models.Role"),
+				},
+				Rounds: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Role.Round.Campaign.Rounds", "models.Round"),
+				},
+			},
+			Creator: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Role.Round.Creator", "models.User"),
+			},
+			DependsOnRound: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Role.Round.DependsOnRound", "models.Round"),
+			},
+			Roles: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Role.Round.Roles", "models.Role"),
+			},
+		},
+		Campaign: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Role.Campaign", "models.Campaign"),
+		},
+		User: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Role.User", "models.User"),
+		},
+		Project: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Role.Project", "models.Project"),
+		},
+	}
+
+	_conflictOfInterest.Round = conflictOfInterestHasOneRound{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Round", "models.Round"),
+	}
+
+	_conflictOfInterest.fillFieldMap()
+
+	return _conflictOfInterest
+}
+
+type conflictOfInterest struct {
+	conflictOfInterestDo
+
+	ALL           field.Asterisk
+	ConflictID    field.String
+	RoleID        field.String
+	RoundID       field.String
+	Type          field.String
+	ParticipantID field.String
+	SubmissionID  field.String
+	Reason        field.String
+	CreatedAt     field.Time
+	Role          conflictOfInterestHasOneRole
+
+	Round conflictOfInterestHasOneRound
+
+	fieldMap map[string]field.Expr
+}
+
+func (c conflictOfInterest) Table(newTableName string) *conflictOfInterest {
+	c.conflictOfInterestDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c conflictOfInterest) As(alias string) *conflictOfInterest {
+	c.conflictOfInterestDo.DO = *(c.conflictOfInterestDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *conflictOfInterest) updateTableName(table string) *conflictOfInterest {
+	c.ALL = field.NewAsterisk(table)
+	c.ConflictID = field.NewString(table, "conflict_id")
+	c.RoleID = field.NewString(table, "role_id")
+	c.RoundID = field.NewString(table, "round_id")
+	c.Type = field.NewString(table, "type")
+	c.ParticipantID = field.NewString(table, "participant_id")
+	c.SubmissionID = field.NewString(table, "submission_id")
+	c.Reason = field.NewString(table, "reason")
+	c.CreatedAt = field.NewTime(table, "created_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *conflictOfInterest) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *conflictOfInterest) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 10)
+	c.fieldMap["conflict_id"] = c.ConflictID
+	c.fieldMap["role_id"] = c.RoleID
+	c.fieldMap["round_id"] = c.RoundID
+	c.fieldMap["type"] = c.Type
+	c.fieldMap["participant_id"] = c.ParticipantID
+	c.fieldMap["submission_id"] = c.SubmissionID
+	c.fieldMap["reason"] = c.Reason
+	c.fieldMap["created_at"] = c.CreatedAt
+
+}
+
+func (c conflictOfInterest) clone(db *gorm.DB) conflictOfInterest {
+	c.conflictOfInterestDo.ReplaceConnPool(db.Statement.ConnPool)
+	c.Role.db = db.Session(&gorm.Session{Initialized: true})
+	c.Role.db.Statement.ConnPool = db.Statement.ConnPool
+	c.Round.db = db.Session(&gorm.Session{Initialized: true})
+	c.Round.db.Statement.ConnPool = db.Statement.ConnPool
+	return c
+}
+
+func (c conflictOfInterest) replaceDB(db *gorm.DB) conflictOfInterest {
+	c.conflictOfInterestDo.ReplaceDB(db)
+	c.Role.db = db.Session(&gorm.Session{})
+	c.Round.db = db.Session(&gorm.Session{})
+	return c
+}
+
+type conflictOfInterestHasOneRole struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Round struct {
+		field.RelationField
+		Campaign struct {
+			field.RelationField
+			CreatedBy struct {
+				field.RelationField
+				LeadingProject struct {
+					field.RelationField
+				}
+			}
+			Project struct {
+				field.RelationField
+			}
+			LatestRound struct {
+				field.RelationField
+			}
+			CampaignTags struct {
+				field.RelationField
+				Campaign struct {
+					field.RelationField
+				}
+			}
+			Roles struct {
+				field.RelationField
+			}
+			Rounds struct {
+				field.RelationField
+			}
+		}
+		Creator struct {
+			field.RelationField
+		}
+		DependsOnRound struct {
+			field.RelationField
+		}
+		Roles struct {
+			field.RelationField
+		}
+	}
+	Campaign struct {
+		field.RelationField
+	}
+	User struct {
+		field.RelationField
+	}
+	Project struct {
+		field.RelationField
+	}
+}
+
+func (a conflictOfInterestHasOneRole) Where(conds ...field.Expr) *conflictOfInterestHasOneRole {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a conflictOfInterestHasOneRole) WithContext(ctx context.Context) *conflictOfInterestHasOneRole {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a conflictOfInterestHasOneRole) Session(session *gorm.Session) *conflictOfInterestHasOneRole {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a conflictOfInterestHasOneRole) Model(m *models.ConflictOfInterest) *conflictOfInterestHasOneRoleTx {
+	return &conflictOfInterestHasOneRoleTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a conflictOfInterestHasOneRole) Unscoped() *conflictOfInterestHasOneRole {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type conflictOfInterestHasOneRoleTx struct{ tx *gorm.Association }
+
+func (a conflictOfInterestHasOneRoleTx) Find() (result *models.Role, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a conflictOfInterestHasOneRoleTx) Append(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoleTx) Replace(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoleTx) Delete(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoleTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a conflictOfInterestHasOneRoleTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a conflictOfInterestHasOneRoleTx) Unscoped() *conflictOfInterestHasOneRoleTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type conflictOfInterestHasOneRound struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a conflictOfInterestHasOneRound) Where(conds ...field.Expr) *conflictOfInterestHasOneRound {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a conflictOfInterestHasOneRound) WithContext(ctx context.Context) *conflictOfInterestHasOneRound {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a conflictOfInterestHasOneRound) Session(session *gorm.Session) *conflictOfInterestHasOneRound {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a conflictOfInterestHasOneRound) Model(m *models.ConflictOfInterest) *conflictOfInterestHasOneRoundTx {
+	return &conflictOfInterestHasOneRoundTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a conflictOfInterestHasOneRound) Unscoped() *conflictOfInterestHasOneRound {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type conflictOfInterestHasOneRoundTx struct{ tx *gorm.Association }
+
+func (a conflictOfInterestHasOneRoundTx) Find() (result *models.Round, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a conflictOfInterestHasOneRoundTx) Append(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoundTx) Replace(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoundTx) Delete(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a conflictOfInterestHasOneRoundTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a conflictOfInterestHasOneRoundTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a conflictOfInterestHasOneRoundTx) Unscoped() *conflictOfInterestHasOneRoundTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type conflictOfInterestDo struct{ gen.DO }
+
+type IConflictOfInterestDo interface {
+	gen.SubQuery
+	Debug() IConflictOfInterestDo
+	WithContext(ctx context.Context) IConflictOfInterestDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IConflictOfInterestDo
+	WriteDB() IConflictOfInterestDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IConflictOfInterestDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IConflictOfInterestDo
+	Not(conds ...gen.Condition) IConflictOfInterestDo
+	Or(conds ...gen.Condition) IConflictOfInterestDo
+	Select(conds ...field.Expr) IConflictOfInterestDo
+	Where(conds ...gen.Condition) IConflictOfInterestDo
+	Order(conds ...field.Expr) IConflictOfInterestDo
+	Distinct(cols ...field.Expr) IConflictOfInterestDo
+	Omit(cols ...field.Expr) IConflictOfInterestDo
+	Join(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo
+	Group(cols ...field.Expr) IConflictOfInterestDo
+	Having(conds ...gen.Condition) IConflictOfInterestDo
+	Limit(limit int) IConflictOfInterestDo
+	Offset(offset int) IConflictOfInterestDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IConflictOfInterestDo
+	Unscoped() IConflictOfInterestDo
+	Create(values ...*models.ConflictOfInterest) error
+	CreateInBatches(values []*models.ConflictOfInterest, batchSize int) error
+	Save(values ...*models.ConflictOfInterest) error
+	First() (*models.ConflictOfInterest, error)
+	Take() (*models.ConflictOfInterest, error)
+	Last() (*models.ConflictOfInterest, error)
+	Find() ([]*models.ConflictOfInterest, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ConflictOfInterest, err error)
+	FindInBatches(result *[]*models.ConflictOfInterest, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.ConflictOfInterest) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IConflictOfInterestDo
+	Assign(attrs ...field.AssignExpr) IConflictOfInterestDo
+	Joins(fields ...field.RelationField) IConflictOfInterestDo
+	Preload(fields ...field.RelationField) IConflictOfInterestDo
+	FirstOrInit() (*models.ConflictOfInterest, error)
+	FirstOrCreate() (*models.ConflictOfInterest, error)
+	FindByPage(offset int, limit int) (result []*models.ConflictOfInterest, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IConflictOfInterestDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c conflictOfInterestDo) Debug() IConflictOfInterestDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c conflictOfInterestDo) WithContext(ctx context.Context) IConflictOfInterestDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c conflictOfInterestDo) ReadDB() IConflictOfInterestDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c conflictOfInterestDo) WriteDB() IConflictOfInterestDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c conflictOfInterestDo) Session(config *gorm.Session) IConflictOfInterestDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c conflictOfInterestDo) Clauses(conds ...clause.Expression) IConflictOfInterestDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c conflictOfInterestDo) Returning(value interface{}, columns ...string) IConflictOfInterestDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c conflictOfInterestDo) Not(conds ...gen.Condition) IConflictOfInterestDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c conflictOfInterestDo) Or(conds ...gen.Condition) IConflictOfInterestDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c conflictOfInterestDo) Select(conds ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c conflictOfInterestDo) Where(conds ...gen.Condition) IConflictOfInterestDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c conflictOfInterestDo) Order(conds ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c conflictOfInterestDo) Distinct(cols ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c conflictOfInterestDo) Omit(cols ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c conflictOfInterestDo) Join(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c conflictOfInterestDo) LeftJoin(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c conflictOfInterestDo) RightJoin(table schema.Tabler, on ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c conflictOfInterestDo) Group(cols ...field.Expr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c conflictOfInterestDo) Having(conds ...gen.Condition) IConflictOfInterestDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c conflictOfInterestDo) Limit(limit int) IConflictOfInterestDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c conflictOfInterestDo) Offset(offset int) IConflictOfInterestDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c conflictOfInterestDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IConflictOfInterestDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c conflictOfInterestDo) Unscoped() IConflictOfInterestDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c conflictOfInterestDo) Create(values ...*models.ConflictOfInterest) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c conflictOfInterestDo) CreateInBatches(values []*models.ConflictOfInterest, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c conflictOfInterestDo) Save(values ...*models.ConflictOfInterest) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c conflictOfInterestDo) First() (*models.ConflictOfInterest, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ConflictOfInterest), nil
+	}
+}
+
+func (c conflictOfInterestDo) Take() (*models.ConflictOfInterest, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ConflictOfInterest), nil
+	}
+}
+
+func (c conflictOfInterestDo) Last() (*models.ConflictOfInterest, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ConflictOfInterest), nil
+	}
+}
+
+func (c conflictOfInterestDo) Find() ([]*models.ConflictOfInterest, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.ConflictOfInterest), err
+}
+
+func (c conflictOfInterestDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ConflictOfInterest, err error) {
+	buf := make([]*models.ConflictOfInterest, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c conflictOfInterestDo) FindInBatches(result *[]*models.ConflictOfInterest, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c conflictOfInterestDo) Attrs(attrs ...field.AssignExpr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c conflictOfInterestDo) Assign(attrs ...field.AssignExpr) IConflictOfInterestDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c conflictOfInterestDo) Joins(fields ...field.RelationField) IConflictOfInterestDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c conflictOfInterestDo) Preload(fields ...field.RelationField) IConflictOfInterestDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c conflictOfInterestDo) FirstOrInit() (*models.ConflictOfInterest, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ConflictOfInterest), nil
+	}
+}
+
+func (c conflictOfInterestDo) FirstOrCreate() (*models.ConflictOfInterest, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ConflictOfInterest), nil
+	}
+}
+
+func (c conflictOfInterestDo) FindByPage(offset int, limit int) (result []*models.ConflictOfInterest, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c conflictOfInterestDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c conflictOfInterestDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c conflictOfInterestDo) Delete(models ...*models.ConflictOfInterest) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *conflictOfInterestDo) withDO(do gen.Dao) *conflictOfInterestDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
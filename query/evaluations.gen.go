@@ -45,6 +45,9 @@ func newEvaluation(db *gorm.DB, opts ...gen.DOOption) evaluation {
 	_evaluation.EvaluatedAt = field.NewTime(tableName, "evaluated_at")
 	_evaluation.SkipExpirationAt = field.NewTime(tableName, "skip_expiration_at")
 	_evaluation.DistributionTaskID = field.NewString(tableName, "distribution_task_id")
+	_evaluation.Rank = field.NewUint(tableName, "rank")
+	_evaluation.CriteriaScores = field.NewField(tableName, "criteria_scores")
+	_evaluation.SkipCount = field.NewUint(tableName, "skip_count")
 	_evaluation.Submission = evaluationBelongsToSubmission{
 		db: db.Session(&gorm.Session{}),
 
@@ -309,6 +312,9 @@ type evaluation struct {
 	EvaluatedAt        field.Time
 	SkipExpirationAt   field.Time
 	DistributionTaskID field.String
+	Rank               field.Uint
+	CriteriaScores     field.Field
+	SkipCount          field.Uint
 	Submission         evaluationBelongsToSubmission
 
 	Participant evaluationBelongsToParticipant
@@ -343,6 +349,9 @@ func (e *evaluation) updateTableName(table string) *evaluation {
 	e.EvaluatedAt = field.NewTime(table, "evaluated_at")
 	e.SkipExpirationAt = field.NewTime(table, "skip_expiration_at")
 	e.DistributionTaskID = field.NewString(table, "distribution_task_id")
+	e.Rank = field.NewUint(table, "rank")
+	e.CriteriaScores = field.NewField(table, "criteria_scores")
+	e.SkipCount = field.NewUint(table, "skip_count")
 
 	e.fillFieldMap()
 
@@ -359,7 +368,7 @@ func (e *evaluation) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (e *evaluation) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 16)
+	e.fieldMap = make(map[string]field.Expr, 19)
 	e.fieldMap["evaluation_id"] = e.EvaluationID
 	e.fieldMap["submission_id"] = e.SubmissionID
 	e.fieldMap["judge_id"] = e.JudgeID
@@ -373,6 +382,9 @@ func (e *evaluation) fillFieldMap() {
 	e.fieldMap["evaluated_at"] = e.EvaluatedAt
 	e.fieldMap["skip_expiration_at"] = e.SkipExpirationAt
 	e.fieldMap["distribution_task_id"] = e.DistributionTaskID
+	e.fieldMap["rank"] = e.Rank
+	e.fieldMap["criteria_scores"] = e.CriteriaScores
+	e.fieldMap["skip_count"] = e.SkipCount
 
 }
 
@@ -784,7 +796,8 @@ type IEvaluationDo interface {
 	DistributeAssignmentsFromSelectedSource(my_judge_id models.IDType, my_user_id models.IDType, round_id string, reassignable_judges []string, task_id models.IDType, N int) (rowsAffected int64, err error)
 	DistributeAssignmentsIncludingUnassigned(my_judge_id models.IDType, my_user_id models.IDType, round_id string, task_id models.IDType, N int) (rowsAffected int64, err error)
 	DistributeTheLastRemainingEvaluations(task_id models.IDType, round_id string) (rowsAffected int64, err error)
-	RemoveRedundantEvaluation(roundID string, quorum int)
+	RemoveRedundantEvaluation(roundID string, quorum int) (rowsAffected int64, err error)
+	RemoveRedundantEvaluationBySubmissionIds(submissionIds []string, quorum int) (rowsAffected int64, err error)
 	FetchTargetSwappables(roundId string, amiJudgeID string, limit int) (result []*models.Evaluation, err error)
 }
 
@@ -982,7 +995,8 @@ func (e evaluationDo) DistributeAssignmentsIncludingUnassigned(my_judge_id model
 // SET e1.judge_id = (
 //
 //	SELECT role_id FROM roles
-//	WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = @round_id)
+//	WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = @round_id AND judge_id IS NOT NULL)
+//	AND user_id NOT IN (SELECT submitted_by_id FROM submissions WHERE submission_id = e1.submission_id UNION SELECT participant_id FROM submissions WHERE submission_id = e1.submission_id)
 //	AND round_id = @round_id
 //	ORDER BY total_evaluated ASC, total_assigned ASC, RAND()
 //	LIMIT 1
@@ -1000,7 +1014,7 @@ func (e evaluationDo) DistributeTheLastRemainingEvaluations(task_id models.IDTyp
 	params = append(params, round_id)
 	params = append(params, task_id)
 	params = append(params, round_id)
-	generateSQL.WriteString("UPDATE `evaluations` e1 SET e1.judge_id = ( SELECT role_id FROM roles WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = ?) AND round_id = ? ORDER BY total_evaluated ASC, total_assigned ASC, RAND() LIMIT 1 ), e1.distribution_task_id = ? WHERE e1.score IS NULL AND e1.evaluated_at IS NULL AND e1.judge_id IS NULL AND e1.round_id = ?; ")
+	generateSQL.WriteString("UPDATE `evaluations` e1 SET e1.judge_id = ( SELECT role_id FROM roles WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = ? AND judge_id IS NOT NULL) AND user_id NOT IN (SELECT submitted_by_id FROM submissions WHERE submission_id = e1.submission_id UNION SELECT participant_id FROM submissions WHERE submission_id = e1.submission_id) AND round_id = ? ORDER BY total_evaluated ASC, total_assigned ASC, RAND() LIMIT 1 ), e1.distribution_task_id = ? WHERE e1.score IS NULL AND e1.evaluated_at IS NULL AND e1.judge_id IS NULL AND e1.round_id = ?; ")
 
 	var executeSQL *gorm.DB
 	executeSQL = e.UnderlyingDB().Exec(generateSQL.String(), params...) // ignore_security_alert
@@ -1010,19 +1024,40 @@ func (e evaluationDo) DistributeTheLastRemainingEvaluations(task_id models.IDTyp
 	return
 }
 
-// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `round_id` = @roundID AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` <= @quorum` AND `round_id` = @roundID)
-func (e evaluationDo) RemoveRedundantEvaluation(roundID string, quorum int) {
+// RemoveRedundantEvaluation deletes the pending assignments of the submissions that have already reached the quorum.
+// It relies on the `evaluation_count` of the submissions, so the submission statistics must be up to date.
+//
+// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `round_id` = @roundID AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= @quorum AND `round_id` = @roundID)
+func (e evaluationDo) RemoveRedundantEvaluation(roundID string, quorum int) (rowsAffected int64, err error) {
 	var params []interface{}
 
 	var generateSQL strings.Builder
 	params = append(params, roundID)
 	params = append(params, quorum)
 	params = append(params, roundID)
-	generateSQL.WriteString("DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `round_id` = ? AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` <= ?` AND `round_id` = ?) ")
+	generateSQL.WriteString("DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `round_id` = ? AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= ? AND `round_id` = ?) ")
+
+	var executeSQL *gorm.DB
+	executeSQL = e.UnderlyingDB().Exec(generateSQL.String(), params...) // ignore_security_alert
+	rowsAffected = executeSQL.RowsAffected
+	err = executeSQL.Error
+
+	return
+}
+
+// DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= @quorum AND `submission_id` IN (@submissionIds))
+func (e evaluationDo) RemoveRedundantEvaluationBySubmissionIds(submissionIds []string, quorum int) (rowsAffected int64, err error) {
+	var params []interface{}
+
+	var generateSQL strings.Builder
+	params = append(params, quorum)
+	params = append(params, submissionIds)
+	generateSQL.WriteString("DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL AND `submission_id` IN (SELECT `submission_id` FROM `submissions` WHERE `evaluation_count` >= ? AND `submission_id` IN (?)) ")
 
 	var executeSQL *gorm.DB
 	executeSQL = e.UnderlyingDB().Exec(generateSQL.String(), params...) // ignore_security_alert
-	_ = executeSQL
+	rowsAffected = executeSQL.RowsAffected
+	err = executeSQL.Error
 
 	return
 }
@@ -19,10 +19,13 @@ var (
 	Q                      = new(Query)
 	Campaign               *campaign
 	Category               *category
+	CommonsCategoryLink    *commonsCategoryLink
 	CommonsSubmissionEntry *commonsSubmissionEntry
+	ConflictOfInterest     *conflictOfInterest
 	Evaluation             *evaluation
 	JuryStatistics         *juryStatistics
 	Project                *project
+	RankingPreference      *rankingPreference
 	Role                   *role
 	Round                  *round
 	RoundStatistics        *roundStatistics
@@ -40,10 +43,13 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	Campaign = &Q.Campaign
 	Category = &Q.Category
+	CommonsCategoryLink = &Q.CommonsCategoryLink
 	CommonsSubmissionEntry = &Q.CommonsSubmissionEntry
+	ConflictOfInterest = &Q.ConflictOfInterest
 	Evaluation = &Q.Evaluation
 	JuryStatistics = &Q.JuryStatistics
 	Project = &Q.Project
+	RankingPreference = &Q.RankingPreference
 	Role = &Q.Role
 	Round = &Q.Round
 	RoundStatistics = &Q.RoundStatistics
@@ -62,10 +68,13 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		db:                     db,
 		Campaign:               newCampaign(db, opts...),
 		Category:               newCategory(db, opts...),
+		CommonsCategoryLink:    newCommonsCategoryLink(db, opts...),
 		CommonsSubmissionEntry: newCommonsSubmissionEntry(db, opts...),
+		ConflictOfInterest:     newConflictOfInterest(db, opts...),
 		Evaluation:             newEvaluation(db, opts...),
 		JuryStatistics:         newJuryStatistics(db, opts...),
 		Project:                newProject(db, opts...),
+		RankingPreference:      newRankingPreference(db, opts...),
 		Role:                   newRole(db, opts...),
 		Round:                  newRound(db, opts...),
 		RoundStatistics:        newRoundStatistics(db, opts...),
@@ -85,10 +94,13 @@ type Query struct {
 
 	Campaign               campaign
 	Category               category
+	CommonsCategoryLink    commonsCategoryLink
 	CommonsSubmissionEntry commonsSubmissionEntry
+	ConflictOfInterest     conflictOfInterest
 	Evaluation             evaluation
 	JuryStatistics         juryStatistics
 	Project                project
+	RankingPreference      rankingPreference
 	Role                   role
 	Round                  round
 	RoundStatistics        roundStatistics
@@ -109,10 +121,13 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		db:                     db,
 		Campaign:               q.Campaign.clone(db),
 		Category:               q.Category.clone(db),
+		CommonsCategoryLink:    q.CommonsCategoryLink.clone(db),
 		CommonsSubmissionEntry: q.CommonsSubmissionEntry.clone(db),
+		ConflictOfInterest:     q.ConflictOfInterest.clone(db),
 		Evaluation:             q.Evaluation.clone(db),
 		JuryStatistics:         q.JuryStatistics.clone(db),
 		Project:                q.Project.clone(db),
+		RankingPreference:      q.RankingPreference.clone(db),
 		Role:                   q.Role.clone(db),
 		Round:                  q.Round.clone(db),
 		RoundStatistics:        q.RoundStatistics.clone(db),
@@ -140,10 +155,13 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		db:                     db,
 		Campaign:               q.Campaign.replaceDB(db),
 		Category:               q.Category.replaceDB(db),
+		CommonsCategoryLink:    q.CommonsCategoryLink.replaceDB(db),
 		CommonsSubmissionEntry: q.CommonsSubmissionEntry.replaceDB(db),
+		ConflictOfInterest:     q.ConflictOfInterest.replaceDB(db),
 		Evaluation:             q.Evaluation.replaceDB(db),
 		JuryStatistics:         q.JuryStatistics.replaceDB(db),
 		Project:                q.Project.replaceDB(db),
+		RankingPreference:      q.RankingPreference.replaceDB(db),
 		Role:                   q.Role.replaceDB(db),
 		Round:                  q.Round.replaceDB(db),
 		RoundStatistics:        q.RoundStatistics.replaceDB(db),
@@ -161,10 +179,13 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 type queryCtx struct {
 	Campaign               ICampaignDo
 	Category               ICategoryDo
+	CommonsCategoryLink    ICommonsCategoryLinkDo
 	CommonsSubmissionEntry ICommonsSubmissionEntryDo
+	ConflictOfInterest     IConflictOfInterestDo
 	Evaluation             IEvaluationDo
 	JuryStatistics         IJuryStatisticsDo
 	Project                IProjectDo
+	RankingPreference      IRankingPreferenceDo
 	Role                   IRoleDo
 	Round                  IRoundDo
 	RoundStatistics        IRoundStatisticsDo
@@ -182,10 +203,13 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
 		Campaign:               q.Campaign.WithContext(ctx),
 		Category:               q.Category.WithContext(ctx),
+		CommonsCategoryLink:    q.CommonsCategoryLink.WithContext(ctx),
 		CommonsSubmissionEntry: q.CommonsSubmissionEntry.WithContext(ctx),
+		ConflictOfInterest:     q.ConflictOfInterest.WithContext(ctx),
 		Evaluation:             q.Evaluation.WithContext(ctx),
 		JuryStatistics:         q.JuryStatistics.WithContext(ctx),
 		Project:                q.Project.WithContext(ctx),
+		RankingPreference:      q.RankingPreference.WithContext(ctx),
 		Role:                   q.Role.WithContext(ctx),
 		Round:                  q.Round.WithContext(ctx),
 		RoundStatistics:        q.RoundStatistics.WithContext(ctx),
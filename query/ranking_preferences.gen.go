@@ -0,0 +1,769 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"nokib/campwiz/models"
+)
+
+func newRankingPreference(db *gorm.DB, opts ...gen.DOOption) rankingPreference {
+	_rankingPreference := rankingPreference{}
+
+	_rankingPreference.rankingPreferenceDo.UseDB(db, opts...)
+	_rankingPreference.rankingPreferenceDo.UseModel(&models.RankingPreference{})
+
+	tableName := _rankingPreference.rankingPreferenceDo.TableName()
+	_rankingPreference.ALL = field.NewAsterisk(tableName)
+	_rankingPreference.PreferenceID = field.NewString(tableName, "preference_id")
+	_rankingPreference.RoundID = field.NewString(tableName, "round_id")
+	_rankingPreference.JudgeID = field.NewString(tableName, "judge_id")
+	_rankingPreference.WinnerID = field.NewString(tableName, "winner_id")
+	_rankingPreference.LoserID = field.NewString(tableName, "loser_id")
+	_rankingPreference.CreatedAt = field.NewTime(tableName, "created_at")
+	_rankingPreference.Round = rankingPreferenceBelongsToRound{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Round", "models.Round"),
+		Campaign: struct {
+			field.RelationField
+			CreatedBy struct {
+				field.RelationField
+				LeadingProject struct {
+					field.RelationField
+				}
+			}
+			Project struct {
+				field.RelationField
+			}
+			LatestRound struct {
+				field.RelationField
+			}
+			CampaignTags struct {
+				field.RelationField
+				Campaign struct {
+					field.RelationField
+				}
+			}
+			Roles struct {
+				field.RelationField
+				Round struct {
+					field.RelationField
+				}
+				Campaign struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+			}
+			Rounds struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Round.Campaign", "models.Campaign"),
+			CreatedBy: struct {
+				field.RelationField
+				LeadingProject struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Round.Campaign.CreatedBy", "models.User"),
+				LeadingProject: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.CreatedBy.LeadingProject", "models.Project"),
+				},
+			},
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Round.Campaign.Project", "models.Project"),
+			},
+			LatestRound: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Round.Campaign.LatestRound", "models.Round"),
+			},
+			CampaignTags: struct {
+				field.RelationField
+				Campaign struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Round.Campaign.CampaignTags", "models.Tag"),
+				Campaign: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.CampaignTags.Campaign", "models.Campaign"),
+				},
+			},
+			Roles: struct {
+				field.RelationField
+				Round struct {
+					field.RelationField
+				}
+				Campaign struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Round.Campaign.Roles", "models.Role"),
+				Round: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.Roles.Round", "models.Round"),
+				},
+				Campaign: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.Roles.Campaign", "models.Campaign"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.Roles.User", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Round.Campaign.Roles.Project", "models.Project"),
+				},
+			},
+			Rounds: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Round.Campaign.Rounds", "models.Round"),
+			},
+		},
+		Creator: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Round.Creator", "models.User"),
+		},
+		DependsOnRound: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Round.DependsOnRound", "models.Round"),
+		},
+		Roles: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Round.Roles", "models.Role"),
+		},
+	}
+
+	_rankingPreference.Judge = rankingPreferenceBelongsToJudge{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Judge", "models.Role"),
+	}
+
+	_rankingPreference.fillFieldMap()
+
+	return _rankingPreference
+}
+
+type rankingPreference struct {
+	rankingPreferenceDo
+
+	ALL          field.Asterisk
+	PreferenceID field.String
+	RoundID      field.String
+	JudgeID      field.String
+	WinnerID     field.String
+	LoserID      field.String
+	CreatedAt    field.Time
+	Round        rankingPreferenceBelongsToRound
+
+	Judge rankingPreferenceBelongsToJudge
+
+	fieldMap map[string]field.Expr
+}
+
+func (r rankingPreference) Table(newTableName string) *rankingPreference {
+	r.rankingPreferenceDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r rankingPreference) As(alias string) *rankingPreference {
+	r.rankingPreferenceDo.DO = *(r.rankingPreferenceDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *rankingPreference) updateTableName(table string) *rankingPreference {
+	r.ALL = field.NewAsterisk(table)
+	r.PreferenceID = field.NewString(table, "preference_id")
+	r.RoundID = field.NewString(table, "round_id")
+	r.JudgeID = field.NewString(table, "judge_id")
+	r.WinnerID = field.NewString(table, "winner_id")
+	r.LoserID = field.NewString(table, "loser_id")
+	r.CreatedAt = field.NewTime(table, "created_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *rankingPreference) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *rankingPreference) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 8)
+	r.fieldMap["preference_id"] = r.PreferenceID
+	r.fieldMap["round_id"] = r.RoundID
+	r.fieldMap["judge_id"] = r.JudgeID
+	r.fieldMap["winner_id"] = r.WinnerID
+	r.fieldMap["loser_id"] = r.LoserID
+	r.fieldMap["created_at"] = r.CreatedAt
+
+}
+
+func (r rankingPreference) clone(db *gorm.DB) rankingPreference {
+	r.rankingPreferenceDo.ReplaceConnPool(db.Statement.ConnPool)
+	r.Round.db = db.Session(&gorm.Session{Initialized: true})
+	r.Round.db.Statement.ConnPool = db.Statement.ConnPool
+	r.Judge.db = db.Session(&gorm.Session{Initialized: true})
+	r.Judge.db.Statement.ConnPool = db.Statement.ConnPool
+	return r
+}
+
+func (r rankingPreference) replaceDB(db *gorm.DB) rankingPreference {
+	r.rankingPreferenceDo.ReplaceDB(db)
+	r.Round.db = db.Session(&gorm.Session{})
+	r.Judge.db = db.Session(&gorm.Session{})
+	return r
+}
+
+type rankingPreferenceBelongsToRound struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Campaign struct {
+		field.RelationField
+		CreatedBy struct {
+			field.RelationField
+			LeadingProject struct {
+				field.RelationField
+			}
+		}
+		Project struct {
+			field.RelationField
+		}
+		LatestRound struct {
+			field.RelationField
+		}
+		CampaignTags struct {
+			field.RelationField
+			Campaign struct {
+				field.RelationField
+			}
+		}
+		Roles struct {
+			field.RelationField
+			Round struct {
+				field.RelationField
+			}
+			Campaign struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+		}
+		Rounds struct {
+			field.RelationField
+		}
+	}
+	Creator struct {
+		field.RelationField
+	}
+	DependsOnRound struct {
+		field.RelationField
+	}
+	Roles struct {
+		field.RelationField
+	}
+}
+
+func (a rankingPreferenceBelongsToRound) Where(conds ...field.Expr) *rankingPreferenceBelongsToRound {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a rankingPreferenceBelongsToRound) WithContext(ctx context.Context) *rankingPreferenceBelongsToRound {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a rankingPreferenceBelongsToRound) Session(session *gorm.Session) *rankingPreferenceBelongsToRound {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a rankingPreferenceBelongsToRound) Model(m *models.RankingPreference) *rankingPreferenceBelongsToRoundTx {
+	return &rankingPreferenceBelongsToRoundTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a rankingPreferenceBelongsToRound) Unscoped() *rankingPreferenceBelongsToRound {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type rankingPreferenceBelongsToRoundTx struct{ tx *gorm.Association }
+
+func (a rankingPreferenceBelongsToRoundTx) Find() (result *models.Round, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Append(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Replace(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Delete(values ...*models.Round) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a rankingPreferenceBelongsToRoundTx) Unscoped() *rankingPreferenceBelongsToRoundTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type rankingPreferenceBelongsToJudge struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a rankingPreferenceBelongsToJudge) Where(conds ...field.Expr) *rankingPreferenceBelongsToJudge {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a rankingPreferenceBelongsToJudge) WithContext(ctx context.Context) *rankingPreferenceBelongsToJudge {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a rankingPreferenceBelongsToJudge) Session(session *gorm.Session) *rankingPreferenceBelongsToJudge {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a rankingPreferenceBelongsToJudge) Model(m *models.RankingPreference) *rankingPreferenceBelongsToJudgeTx {
+	return &rankingPreferenceBelongsToJudgeTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a rankingPreferenceBelongsToJudge) Unscoped() *rankingPreferenceBelongsToJudge {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type rankingPreferenceBelongsToJudgeTx struct{ tx *gorm.Association }
+
+func (a rankingPreferenceBelongsToJudgeTx) Find() (result *models.Role, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Append(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Replace(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Delete(values ...*models.Role) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a rankingPreferenceBelongsToJudgeTx) Unscoped() *rankingPreferenceBelongsToJudgeTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type rankingPreferenceDo struct{ gen.DO }
+
+type IRankingPreferenceDo interface {
+	gen.SubQuery
+	Debug() IRankingPreferenceDo
+	WithContext(ctx context.Context) IRankingPreferenceDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRankingPreferenceDo
+	WriteDB() IRankingPreferenceDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRankingPreferenceDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRankingPreferenceDo
+	Not(conds ...gen.Condition) IRankingPreferenceDo
+	Or(conds ...gen.Condition) IRankingPreferenceDo
+	Select(conds ...field.Expr) IRankingPreferenceDo
+	Where(conds ...gen.Condition) IRankingPreferenceDo
+	Order(conds ...field.Expr) IRankingPreferenceDo
+	Distinct(cols ...field.Expr) IRankingPreferenceDo
+	Omit(cols ...field.Expr) IRankingPreferenceDo
+	Join(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo
+	Group(cols ...field.Expr) IRankingPreferenceDo
+	Having(conds ...gen.Condition) IRankingPreferenceDo
+	Limit(limit int) IRankingPreferenceDo
+	Offset(offset int) IRankingPreferenceDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRankingPreferenceDo
+	Unscoped() IRankingPreferenceDo
+	Create(values ...*models.RankingPreference) error
+	CreateInBatches(values []*models.RankingPreference, batchSize int) error
+	Save(values ...*models.RankingPreference) error
+	First() (*models.RankingPreference, error)
+	Take() (*models.RankingPreference, error)
+	Last() (*models.RankingPreference, error)
+	Find() ([]*models.RankingPreference, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.RankingPreference, err error)
+	FindInBatches(result *[]*models.RankingPreference, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.RankingPreference) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRankingPreferenceDo
+	Assign(attrs ...field.AssignExpr) IRankingPreferenceDo
+	Joins(fields ...field.RelationField) IRankingPreferenceDo
+	Preload(fields ...field.RelationField) IRankingPreferenceDo
+	FirstOrInit() (*models.RankingPreference, error)
+	FirstOrCreate() (*models.RankingPreference, error)
+	FindByPage(offset int, limit int) (result []*models.RankingPreference, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRankingPreferenceDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r rankingPreferenceDo) Debug() IRankingPreferenceDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r rankingPreferenceDo) WithContext(ctx context.Context) IRankingPreferenceDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r rankingPreferenceDo) ReadDB() IRankingPreferenceDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r rankingPreferenceDo) WriteDB() IRankingPreferenceDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r rankingPreferenceDo) Session(config *gorm.Session) IRankingPreferenceDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r rankingPreferenceDo) Clauses(conds ...clause.Expression) IRankingPreferenceDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r rankingPreferenceDo) Returning(value interface{}, columns ...string) IRankingPreferenceDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r rankingPreferenceDo) Not(conds ...gen.Condition) IRankingPreferenceDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r rankingPreferenceDo) Or(conds ...gen.Condition) IRankingPreferenceDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r rankingPreferenceDo) Select(conds ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r rankingPreferenceDo) Where(conds ...gen.Condition) IRankingPreferenceDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r rankingPreferenceDo) Order(conds ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r rankingPreferenceDo) Distinct(cols ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r rankingPreferenceDo) Omit(cols ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r rankingPreferenceDo) Join(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r rankingPreferenceDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r rankingPreferenceDo) RightJoin(table schema.Tabler, on ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r rankingPreferenceDo) Group(cols ...field.Expr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r rankingPreferenceDo) Having(conds ...gen.Condition) IRankingPreferenceDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r rankingPreferenceDo) Limit(limit int) IRankingPreferenceDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r rankingPreferenceDo) Offset(offset int) IRankingPreferenceDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r rankingPreferenceDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRankingPreferenceDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r rankingPreferenceDo) Unscoped() IRankingPreferenceDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r rankingPreferenceDo) Create(values ...*models.RankingPreference) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r rankingPreferenceDo) CreateInBatches(values []*models.RankingPreference, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r rankingPreferenceDo) Save(values ...*models.RankingPreference) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r rankingPreferenceDo) First() (*models.RankingPreference, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RankingPreference), nil
+	}
+}
+
+func (r rankingPreferenceDo) Take() (*models.RankingPreference, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RankingPreference), nil
+	}
+}
+
+func (r rankingPreferenceDo) Last() (*models.RankingPreference, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RankingPreference), nil
+	}
+}
+
+func (r rankingPreferenceDo) Find() ([]*models.RankingPreference, error) {
+	result, err := r.DO.Find()
+	return result.([]*models.RankingPreference), err
+}
+
+func (r rankingPreferenceDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.RankingPreference, err error) {
+	buf := make([]*models.RankingPreference, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r rankingPreferenceDo) FindInBatches(result *[]*models.RankingPreference, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r rankingPreferenceDo) Attrs(attrs ...field.AssignExpr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r rankingPreferenceDo) Assign(attrs ...field.AssignExpr) IRankingPreferenceDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r rankingPreferenceDo) Joins(fields ...field.RelationField) IRankingPreferenceDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r rankingPreferenceDo) Preload(fields ...field.RelationField) IRankingPreferenceDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r rankingPreferenceDo) FirstOrInit() (*models.RankingPreference, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RankingPreference), nil
+	}
+}
+
+func (r rankingPreferenceDo) FirstOrCreate() (*models.RankingPreference, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RankingPreference), nil
+	}
+}
+
+func (r rankingPreferenceDo) FindByPage(offset int, limit int) (result []*models.RankingPreference, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r rankingPreferenceDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r rankingPreferenceDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r rankingPreferenceDo) Delete(models ...*models.RankingPreference) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *rankingPreferenceDo) withDO(do gen.Dao) *rankingPreferenceDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
@@ -40,6 +40,7 @@ func newRole(db *gorm.DB, opts ...gen.DOOption) role {
 	_role.TotalScore = field.NewInt(tableName, "total_score")
 	_role.Permission = field.NewField(tableName, "permission")
 	_role.DeletedAt = field.NewField(tableName, "deleted_at")
+	_role.Capacity = field.NewInt(tableName, "capacity")
 	_role.Round = roleBelongsToRound{
 		db: db.Session(&gorm.Session{}),
 
@@ -219,6 +220,7 @@ type role struct {
 	TotalScore      field.Int
 	Permission      field.Field
 	DeletedAt       field.Field
+	Capacity        field.Int
 	Round           roleBelongsToRound
 
 	Campaign roleBelongsToCampaign
@@ -254,6 +256,7 @@ func (r *role) updateTableName(table string) *role {
 	r.TotalScore = field.NewInt(table, "total_score")
 	r.Permission = field.NewField(table, "permission")
 	r.DeletedAt = field.NewField(table, "deleted_at")
+	r.Capacity = field.NewInt(table, "capacity")
 
 	r.fillFieldMap()
 
@@ -270,7 +273,7 @@ func (r *role) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *role) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 16)
+	r.fieldMap = make(map[string]field.Expr, 17)
 	r.fieldMap["role_id"] = r.RoleID
 	r.fieldMap["type"] = r.Type
 	r.fieldMap["user_id"] = r.UserID
@@ -283,6 +286,7 @@ func (r *role) fillFieldMap() {
 	r.fieldMap["total_score"] = r.TotalScore
 	r.fieldMap["permission"] = r.Permission
 	r.fieldMap["deleted_at"] = r.DeletedAt
+	r.fieldMap["capacity"] = r.Capacity
 
 }
 
@@ -54,6 +54,7 @@ func newRound(db *gorm.DB, opts ...gen.DOOption) round {
 	_round.AllowMultipleJudgement = field.NewBool(tableName, "allow_multiple_judgement")
 	_round.SecretBallot = field.NewBool(tableName, "secret_ballot")
 	_round.Blacklist = field.NewString(tableName, "blacklist")
+	_round.BlindJudging = field.NewBool(tableName, "blind_judging")
 	_round.ImageMinimumResolution = field.NewInt(tableName, "image_minimum_resolution")
 	_round.ImageMinimumSizeBytes = field.NewInt(tableName, "image_minimum_size_bytes")
 	_round.AudioMinimumDurationMilliseconds = field.NewInt(tableName, "audio_minimum_duration_milliseconds")
@@ -69,6 +70,11 @@ func newRound(db *gorm.DB, opts ...gen.DOOption) round {
 	_round.ArticleMinimumAddedBytes = field.NewInt(tableName, "article_minimum_added_bytes")
 	_round.ArticleMinimumAddedWords = field.NewInt(tableName, "article_minimum_added_words")
 	_round.AllowedMediaTypes = field.NewField(tableName, "allowed_media_types")
+	_round.Rubric = field.NewField(tableName, "rubric")
+	_round.ScoreNormalization = field.NewString(tableName, "score_normalization")
+	_round.SkipReassignmentThreshold = field.NewUint(tableName, "skip_reassignment_threshold")
+	_round.DistributionStrategy = field.NewString(tableName, "distribution_strategy")
+	_round.TrimRedundantEvaluations = field.NewBool(tableName, "trim_redundant_evaluations")
 	_round.Roles = roundHasManyRoles{
 		db: db.Session(&gorm.Session{}),
 
@@ -274,6 +280,7 @@ type round struct {
 	AllowMultipleJudgement           field.Bool
 	SecretBallot                     field.Bool
 	Blacklist                        field.String
+	BlindJudging                     field.Bool
 	ImageMinimumResolution           field.Int
 	ImageMinimumSizeBytes            field.Int
 	AudioMinimumDurationMilliseconds field.Int
@@ -289,6 +296,11 @@ type round struct {
 	ArticleMinimumAddedBytes         field.Int
 	ArticleMinimumAddedWords         field.Int
 	AllowedMediaTypes                field.Field
+	Rubric                           field.Field
+	ScoreNormalization               field.String
+	SkipReassignmentThreshold        field.Uint
+	DistributionStrategy             field.String
+	TrimRedundantEvaluations         field.Bool
 	Roles                            roundHasManyRoles
 
 	Campaign roundBelongsToCampaign
@@ -338,6 +350,7 @@ func (r *round) updateTableName(table string) *round {
 	r.AllowMultipleJudgement = field.NewBool(table, "allow_multiple_judgement")
 	r.SecretBallot = field.NewBool(table, "secret_ballot")
 	r.Blacklist = field.NewString(table, "blacklist")
+	r.BlindJudging = field.NewBool(table, "blind_judging")
 	r.ImageMinimumResolution = field.NewInt(table, "image_minimum_resolution")
 	r.ImageMinimumSizeBytes = field.NewInt(table, "image_minimum_size_bytes")
 	r.AudioMinimumDurationMilliseconds = field.NewInt(table, "audio_minimum_duration_milliseconds")
@@ -353,6 +366,11 @@ func (r *round) updateTableName(table string) *round {
 	r.ArticleMinimumAddedBytes = field.NewInt(table, "article_minimum_added_bytes")
 	r.ArticleMinimumAddedWords = field.NewInt(table, "article_minimum_added_words")
 	r.AllowedMediaTypes = field.NewField(table, "allowed_media_types")
+	r.Rubric = field.NewField(table, "rubric")
+	r.ScoreNormalization = field.NewString(table, "score_normalization")
+	r.SkipReassignmentThreshold = field.NewUint(table, "skip_reassignment_threshold")
+	r.DistributionStrategy = field.NewString(table, "distribution_strategy")
+	r.TrimRedundantEvaluations = field.NewBool(table, "trim_redundant_evaluations")
 
 	r.fillFieldMap()
 
@@ -369,7 +387,7 @@ func (r *round) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *round) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 45)
+	r.fieldMap = make(map[string]field.Expr, 51)
 	r.fieldMap["round_id"] = r.RoundID
 	r.fieldMap["campaign_id"] = r.CampaignID
 	r.fieldMap["project_id"] = r.ProjectID
@@ -396,6 +414,7 @@ func (r *round) fillFieldMap() {
 	r.fieldMap["allow_multiple_judgement"] = r.AllowMultipleJudgement
 	r.fieldMap["secret_ballot"] = r.SecretBallot
 	r.fieldMap["blacklist"] = r.Blacklist
+	r.fieldMap["blind_judging"] = r.BlindJudging
 	r.fieldMap["image_minimum_resolution"] = r.ImageMinimumResolution
 	r.fieldMap["image_minimum_size_bytes"] = r.ImageMinimumSizeBytes
 	r.fieldMap["audio_minimum_duration_milliseconds"] = r.AudioMinimumDurationMilliseconds
@@ -411,6 +430,11 @@ func (r *round) fillFieldMap() {
 	r.fieldMap["article_minimum_added_bytes"] = r.ArticleMinimumAddedBytes
 	r.fieldMap["article_minimum_added_words"] = r.ArticleMinimumAddedWords
 	r.fieldMap["allowed_media_types"] = r.AllowedMediaTypes
+	r.fieldMap["rubric"] = r.Rubric
+	r.fieldMap["score_normalization"] = r.ScoreNormalization
+	r.fieldMap["skip_reassignment_threshold"] = r.SkipReassignmentThreshold
+	r.fieldMap["distribution_strategy"] = r.DistributionStrategy
+	r.fieldMap["trim_redundant_evaluations"] = r.TrimRedundantEvaluations
 
 }
 
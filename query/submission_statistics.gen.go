@@ -180,6 +180,10 @@ func (s submissionStatisticsDo) FetchByRoundID(round_id string) (result []models
 	return
 }
 
+// TriggerBySubmissionIds aggregates the evaluations of the submissions. For the rounds with a rubric,
+// the score of every evaluation is already the weighted total of its criteria (see Rubric.WeightedScore)
+// so the average is the weighted aggregation of the rubric.
+//
 // UPDATE `submissions` JOIN (SELECT AVG(`evaluations`.`score`) As `Score`, COUNT(`evaluations`.`evaluation_id`) AS `AssignmentCount`, SUM(`evaluations`.`score` IS NOT NULL) AS `EvaluationCount`,`evaluations`.`submission_id` FROM `evaluations` WHERE  `evaluations`.submission_id IN (@submissionIds) GROUP BY `evaluations`.`submission_id`) AS `e` ON `submissions`.`submission_id` = `e`.`submission_id` SET `submissions`.`assignment_count` = `e`.`AssignmentCount`, `submissions`.`evaluation_count` = `e`.`EvaluationCount`, `submissions`.`score` = `e`.`Score` WHERE `submissions`.`submission_id` = `e`.`submission_id`
 func (s submissionStatisticsDo) TriggerBySubmissionIds(submissionIds []string) (rowsAffected int64, err error) {
 	var params []interface{}
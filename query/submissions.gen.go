@@ -44,6 +44,7 @@ func newSubmission(db *gorm.DB, opts ...gen.DOOption) submission {
 	_submission.ImportTaskID = field.NewString(tableName, "import_task_id")
 	_submission.AssignmentCount = field.NewUint(tableName, "assignment_count")
 	_submission.EvaluationCount = field.NewUint(tableName, "evaluation_count")
+	_submission.SkippedJuryCount = field.NewUint(tableName, "skipped_jury_count")
 	_submission.MediaType = field.NewString(tableName, "media_type")
 	_submission.ThumbURL = field.NewString(tableName, "thumb_url")
 	_submission.ThumbWidth = field.NewUint64(tableName, "thumb_width")
@@ -58,6 +59,12 @@ func newSubmission(db *gorm.DB, opts ...gen.DOOption) submission {
 	_submission.Duration = field.NewUint64(tableName, "duration")
 	_submission.Bitrate = field.NewUint64(tableName, "bitrate")
 	_submission.Size = field.NewUint64(tableName, "size")
+	_submission.Language = field.NewString(tableName, "language")
+	_submission.TotalBytes = field.NewUint64(tableName, "total_bytes")
+	_submission.TotalWords = field.NewUint64(tableName, "total_words")
+	_submission.AddedBytes = field.NewUint64(tableName, "added_bytes")
+	_submission.AddedWords = field.NewUint64(tableName, "added_words")
+	_submission.IsNewArticle = field.NewBool(tableName, "is_new_article")
 	_submission.Participant = submissionBelongsToParticipant{
 		db: db.Session(&gorm.Session{}),
 
@@ -256,6 +263,7 @@ type submission struct {
 	ImportTaskID       field.String
 	AssignmentCount    field.Uint
 	EvaluationCount    field.Uint
+	SkippedJuryCount   field.Uint
 	MediaType          field.String
 	ThumbURL           field.String
 	ThumbWidth         field.Uint64
@@ -270,6 +278,12 @@ type submission struct {
 	Duration           field.Uint64
 	Bitrate            field.Uint64
 	Size               field.Uint64
+	Language           field.String
+	TotalBytes         field.Uint64
+	TotalWords         field.Uint64
+	AddedBytes         field.Uint64
+	AddedWords         field.Uint64
+	IsNewArticle       field.Bool
 	Participant        submissionBelongsToParticipant
 
 	Submitter submissionBelongsToSubmitter
@@ -313,6 +327,7 @@ func (s *submission) updateTableName(table string) *submission {
 	s.ImportTaskID = field.NewString(table, "import_task_id")
 	s.AssignmentCount = field.NewUint(table, "assignment_count")
 	s.EvaluationCount = field.NewUint(table, "evaluation_count")
+	s.SkippedJuryCount = field.NewUint(table, "skipped_jury_count")
 	s.MediaType = field.NewString(table, "media_type")
 	s.ThumbURL = field.NewString(table, "thumb_url")
 	s.ThumbWidth = field.NewUint64(table, "thumb_width")
@@ -327,6 +342,12 @@ func (s *submission) updateTableName(table string) *submission {
 	s.Duration = field.NewUint64(table, "duration")
 	s.Bitrate = field.NewUint64(table, "bitrate")
 	s.Size = field.NewUint64(table, "size")
+	s.Language = field.NewString(table, "language")
+	s.TotalBytes = field.NewUint64(table, "total_bytes")
+	s.TotalWords = field.NewUint64(table, "total_words")
+	s.AddedBytes = field.NewUint64(table, "added_bytes")
+	s.AddedWords = field.NewUint64(table, "added_words")
+	s.IsNewArticle = field.NewBool(table, "is_new_article")
 
 	s.fillFieldMap()
 
@@ -343,7 +364,7 @@ func (s *submission) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *submission) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 36)
+	s.fieldMap = make(map[string]field.Expr, 43)
 	s.fieldMap["submission_id"] = s.SubmissionID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["campaign_id"] = s.CampaignID
@@ -360,6 +381,7 @@ func (s *submission) fillFieldMap() {
 	s.fieldMap["import_task_id"] = s.ImportTaskID
 	s.fieldMap["assignment_count"] = s.AssignmentCount
 	s.fieldMap["evaluation_count"] = s.EvaluationCount
+	s.fieldMap["skipped_jury_count"] = s.SkippedJuryCount
 	s.fieldMap["media_type"] = s.MediaType
 	s.fieldMap["thumb_url"] = s.ThumbURL
 	s.fieldMap["thumb_width"] = s.ThumbWidth
@@ -374,6 +396,12 @@ func (s *submission) fillFieldMap() {
 	s.fieldMap["duration"] = s.Duration
 	s.fieldMap["bitrate"] = s.Bitrate
 	s.fieldMap["size"] = s.Size
+	s.fieldMap["language"] = s.Language
+	s.fieldMap["total_bytes"] = s.TotalBytes
+	s.fieldMap["total_words"] = s.TotalWords
+	s.fieldMap["added_bytes"] = s.AddedBytes
+	s.fieldMap["added_words"] = s.AddedWords
+	s.fieldMap["is_new_article"] = s.IsNewArticle
 
 }
 
@@ -117,7 +117,8 @@ type Dirtributor interface {
 	// UPDATE `evaluations` e1
 	// SET e1.judge_id = (
 	// 		SELECT role_id FROM roles
-	// 		WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = @round_id)
+	// 		WHERE role_id NOT IN (SELECT judge_id FROM evaluations WHERE submission_id = e1.submission_id AND round_id = @round_id AND judge_id IS NOT NULL)
+	// 		AND user_id NOT IN (SELECT submitted_by_id FROM submissions WHERE submission_id = e1.submission_id UNION SELECT participant_id FROM submissions WHERE submission_id = e1.submission_id)
 	// 		AND round_id = @round_id
 	// 		ORDER BY total_evaluated ASC, total_assigned ASC, RAND()
 	// 		LIMIT 1
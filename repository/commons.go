@@ -235,6 +235,83 @@ func (c *CommonsRepository) GetImagesFromCommonsCategories2(ctx context.Context,
 	// Create batch from commons category
 	return
 }
+
+// ExpandCommonsCategories returns the categories along with their subcategories up to the given depth, level by level.
+// The excluded categories are neither returned nor traversed and every category is visited only once,
+// so the cycles of the category graph are harmless.
+func (c *CommonsRepository) ExpandCommonsCategories(ctx context.Context, categories []string, excluded []string, depth int) ([]string, error) {
+	excludedSet := map[string]struct{}{}
+	for _, category := range excluded {
+		excludedSet[category] = struct{}{}
+	}
+	visited := map[string]struct{}{}
+	result := []string{}
+	level := []string{}
+	for _, category := range categories {
+		if _, ok := excludedSet[category]; ok {
+			continue
+		}
+		if _, ok := visited[category]; ok {
+			continue
+		}
+		visited[category] = struct{}{}
+		result = append(result, category)
+		level = append(level, category)
+	}
+	if depth <= 0 || len(level) == 0 {
+		return result, nil
+	}
+	q, close := GetCommonsReplicaWithGen(ctx)
+	defer close()
+	const batchSize = 15000
+	for currentDepth := 0; currentDepth < depth && len(level) > 0; currentDepth++ {
+		nextLevel := []string{}
+		for start := 0; start < len(level); start += batchSize {
+			end := min(start+batchSize, len(level))
+			subcategories, err := q.CommonsCategoryLink.FetchSubcategories(level[start:end])
+			if err != nil {
+				return nil, err
+			}
+			for _, subcategory := range subcategories {
+				if _, ok := excludedSet[subcategory.PageTitle]; ok {
+					continue
+				}
+				if _, ok := visited[subcategory.PageTitle]; ok {
+					continue
+				}
+				visited[subcategory.PageTitle] = struct{}{}
+				result = append(result, subcategory.PageTitle)
+				nextLevel = append(nextLevel, subcategory.PageTitle)
+			}
+		}
+		level = nextLevel
+		log.Printf("Found %d new subcategories at depth %d", len(level), currentDepth+1)
+	}
+	return result, nil
+}
+
+// FetchPagesInCategories returns the pages among pageIds which are directly in any of the categories
+// along with one of those categories
+func (c *CommonsRepository) FetchPagesInCategories(ctx context.Context, categories []string, pageIds []uint64) (map[uint64]string, error) {
+	const batchSize = 15000
+	result := map[uint64]string{}
+	if len(categories) == 0 || len(pageIds) == 0 {
+		return result, nil
+	}
+	q, close := GetCommonsReplicaWithGen(ctx)
+	defer close()
+	for start := 0; start < len(pageIds); start += batchSize {
+		end := min(start+batchSize, len(pageIds))
+		links, err := q.CommonsCategoryLink.FetchCategoryMembership(categories, pageIds[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range links {
+			result[link.ClFrom] = link.ClTo
+		}
+	}
+	return result, nil
+}
 func (c *CommonsRepository) GetImageThumbFromURL(fileURL string, aspectRatio float32, targetWidth uint64) (string, uint64, uint64) {
 	// file name is the last part of the URL
 	fileNameWithoutPrefix := fileURL[strings.LastIndex(fileURL, "/")+1:]
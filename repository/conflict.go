@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+
+	"gorm.io/gorm"
+)
+
+type ConflictRepository struct{}
+
+func NewConflictRepository() *ConflictRepository {
+	return &ConflictRepository{}
+}
+func (r *ConflictRepository) Create(tx *gorm.DB, conflicts []models.ConflictOfInterest) error {
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return tx.Create(&conflicts).Error
+}
+func (r *ConflictRepository) ListByRoundID(tx *gorm.DB, roundID models.IDType) ([]models.ConflictOfInterest, error) {
+	conflicts := []models.ConflictOfInterest{}
+	q := query.Use(tx)
+	err := q.ConflictOfInterest.Where(q.ConflictOfInterest.RoundID.Eq(roundID.String())).Scan(&conflicts)
+	return conflicts, err
+}
+func (r *ConflictRepository) FetchIndex(tx *gorm.DB, roundID models.IDType) (*models.ConflictIndex, error) {
+	conflicts, err := r.ListByRoundID(tx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewConflictIndex(conflicts), nil
+}
+
+// ReassignConflictingAssignments moves the unevaluated assignments that conflict with their jury
+// to the least loaded jury of the round without any conflict. If no such jury exists, the assignment is
+// left unassigned for the next distribution. It returns the number of the affected assignments.
+func (r *ConflictRepository) ReassignConflictingAssignments(tx *gorm.DB, roundID models.IDType, taskID *models.IDType) (int, error) {
+	conflicts, err := r.ListByRoundID(tx, roundID)
+	if err != nil {
+		return 0, err
+	}
+	if len(conflicts) == 0 {
+		return 0, nil
+	}
+	index := models.NewConflictIndex(conflicts)
+	conflictedJudgeIds := []string{}
+	seen := map[models.IDType]bool{}
+	for _, conflict := range conflicts {
+		if !seen[conflict.RoleID] {
+			seen[conflict.RoleID] = true
+			conflictedJudgeIds = append(conflictedJudgeIds, conflict.RoleID.String())
+		}
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	candidates, err := Evaluation.Preload(Evaluation.Submission).
+		Where(Evaluation.RoundID.Eq(roundID.String()), Evaluation.JudgeID.In(conflictedJudgeIds...),
+			Evaluation.Score.IsNull(), Evaluation.EvaluatedAt.IsNull()).Find()
+	if err != nil {
+		return 0, err
+	}
+	conflicting := []*models.Evaluation{}
+	for _, evaluation := range candidates {
+		if evaluation.JudgeID != nil && index.Conflicts(*evaluation.JudgeID, evaluation.Submission) {
+			conflicting = append(conflicting, evaluation)
+		}
+	}
+	if len(conflicting) == 0 {
+		return 0, nil
+	}
+	if err := r.reassign(tx, roundID, taskID, conflicting, index); err != nil {
+		return 0, err
+	}
+	return len(conflicting), nil
+}
+
+// FindSelfEvaluations returns the assignments of a round where the jury is the submitter or the participant of the submission.
+// If onlyUnevaluated is true, the already evaluated ones are excluded.
+func (r *ConflictRepository) FindSelfEvaluations(tx *gorm.DB, roundID models.IDType, onlyUnevaluated bool) ([]*models.Evaluation, error) {
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	Role := q.Role
+	Submission := q.Submission
+	stmt := Evaluation.Select(Evaluation.ALL).Preload(Evaluation.Submission).
+		Join(Role, Role.RoleID.EqCol(Evaluation.JudgeID)).
+		Join(Submission, Submission.SubmissionID.EqCol(Evaluation.SubmissionID)).
+		Where(Evaluation.RoundID.Eq(roundID.String())).
+		Where(Evaluation.Where(Role.UserID.EqCol(Submission.SubmittedByID)).Or(Role.UserID.EqCol(Submission.ParticipantID)))
+	if onlyUnevaluated {
+		stmt = stmt.Where(Evaluation.Score.IsNull(), Evaluation.EvaluatedAt.IsNull())
+	}
+	return stmt.Find()
+}
+
+// ReassignSelfEvaluations moves the unevaluated assignments of the juries to their own submissions
+// to the least loaded jury of the round who is allowed to evaluate them. It returns the number of the affected assignments.
+func (r *ConflictRepository) ReassignSelfEvaluations(tx *gorm.DB, roundID models.IDType, taskID *models.IDType) (int, error) {
+	selfEvaluations, err := r.FindSelfEvaluations(tx, roundID, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(selfEvaluations) == 0 {
+		return 0, nil
+	}
+	index, err := r.FetchIndex(tx, roundID)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.reassign(tx, roundID, taskID, selfEvaluations, index); err != nil {
+		return 0, err
+	}
+	return len(selfEvaluations), nil
+}
+
+// reassign hands the given evaluations to the least loaded jury of the round who is neither the submitter,
+// nor in conflict with, nor already assigned to the submission. If there is no such jury, the evaluation is left unassigned.
+func (r *ConflictRepository) reassign(tx *gorm.DB, roundID models.IDType, taskID *models.IDType, evaluations []*models.Evaluation, index *models.ConflictIndex) error {
+	role_repo := NewRoleRepository()
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(tx, &models.RoleFilter{RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		return err
+	}
+	submissionIds := []string{}
+	for _, evaluation := range evaluations {
+		submissionIds = append(submissionIds, evaluation.SubmissionID.String())
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	existing, err := Evaluation.Select(Evaluation.SubmissionID, Evaluation.JudgeID).
+		Where(Evaluation.SubmissionID.In(submissionIds...), Evaluation.JudgeID.IsNotNull()).Find()
+	if err != nil {
+		return err
+	}
+	assigned := map[types.SubmissionIDType]map[models.IDType]bool{}
+	for _, evaluation := range existing {
+		if assigned[evaluation.SubmissionID] == nil {
+			assigned[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		assigned[evaluation.SubmissionID][*evaluation.JudgeID] = true
+	}
+	load := map[models.IDType]int{}
+	for _, jury := range juries {
+		load[jury.RoleID] = jury.TotalAssigned
+	}
+	for _, evaluation := range evaluations {
+		submission := evaluation.Submission
+		var replacement *models.IDType
+		for i := range juries {
+			jury := &juries[i]
+			if assigned[evaluation.SubmissionID][jury.RoleID] || jury.UserID == submission.SubmittedByID ||
+				jury.UserID == submission.ParticipantID || index.Conflicts(jury.RoleID, submission) {
+				continue
+			}
+			if replacement == nil || load[jury.RoleID] < load[*replacement] {
+				replacement = &jury.RoleID
+			}
+		}
+		updates := map[string]any{"judge_id": replacement}
+		if taskID != nil {
+			updates["distribution_task_id"] = *taskID
+		}
+		if res := tx.Model(&models.Evaluation{EvaluationID: evaluation.EvaluationID}).Updates(updates); res.Error != nil {
+			return res.Error
+		}
+		if evaluation.JudgeID != nil {
+			load[*evaluation.JudgeID]--
+		}
+		if replacement != nil {
+			load[*replacement]++
+			if assigned[evaluation.SubmissionID] == nil {
+				assigned[evaluation.SubmissionID] = map[models.IDType]bool{}
+			}
+			assigned[evaluation.SubmissionID][*replacement] = true
+		}
+	}
+	return q.JuryStatistics.TriggerByRoundID(roundID.String())
+}
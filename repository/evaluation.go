@@ -5,6 +5,7 @@ import (
 	"nokib/campwiz/models"
 	"nokib/campwiz/models/types"
 	"nokib/campwiz/query"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -59,10 +60,12 @@ func (r *EvaluationRepository) ListAllEvaluations(tx *gorm.DB, filter *models.Ev
 		}
 
 		if filter.IncludeSkipped != nil {
+			// A skip is only in effect until it expires
+			now := time.Now().UTC()
 			if *filter.IncludeSkipped {
-				stmt1 = stmt1.Where(Evaluation.SkipExpirationAt.IsNotNull())
+				stmt1 = stmt1.Where(Evaluation.SkipExpirationAt.IsNotNull(), Evaluation.SkipExpirationAt.Gt(now))
 			} else {
-				stmt1 = stmt1.Where(Evaluation.SkipExpirationAt.IsNull())
+				stmt1 = stmt1.Where(Evaluation.Where(Evaluation.SkipExpirationAt.IsNull()).Or(Evaluation.SkipExpirationAt.Lte(now)))
 			}
 		}
 		if filter.SubmissionID != "" {
@@ -116,3 +119,31 @@ func (e *EvaluationRepository) TriggerEvaluationScoreCount(tx *gorm.DB, submissi
 	submission_repo := NewSubmissionRepository()
 	return submission_repo.TriggerSubmissionStatistics(tx, stringSubmissionIds)
 }
+
+// TrimRedundantEvaluations deletes the pending assignments of the given submissions that have already reached the quorum,
+// then refreshes the statistics of the submissions and the juries. It returns the number of the deleted assignments.
+func (e *EvaluationRepository) TrimRedundantEvaluations(tx *gorm.DB, roundID models.IDType, quorum uint, submissionIds []types.SubmissionIDType) (int, error) {
+	if len(submissionIds) == 0 {
+		return 0, nil
+	}
+	// the evaluation count of the submissions must be up to date
+	if err := e.TriggerEvaluationScoreCount(tx, submissionIds); err != nil {
+		return 0, err
+	}
+	stringSubmissionIds := make([]string, len(submissionIds))
+	for i, id := range submissionIds {
+		stringSubmissionIds[i] = string(id)
+	}
+	q := query.Use(tx)
+	deleted, err := q.Evaluation.RemoveRedundantEvaluationBySubmissionIds(stringSubmissionIds, int(quorum))
+	if err != nil {
+		return 0, err
+	}
+	if deleted == 0 {
+		return 0, nil
+	}
+	if err := e.TriggerEvaluationScoreCount(tx, submissionIds); err != nil {
+		return 0, err
+	}
+	return int(deleted), q.JuryStatistics.TriggerByRoundID(roundID.String())
+}
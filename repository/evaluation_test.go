@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// Only the pending assignments of the submissions which have reached the quorum must be deleted
+var trimEvaluationsPattern = regexp.QuoteMeta("DELETE FROM `evaluations` WHERE `evaluations`.`evaluated_at` IS NULL AND `evaluations`.`score` IS NULL") +
+	".*" + regexp.QuoteMeta("`evaluation_count` >= ?")
+
+func TestRemoveRedundantEvaluation(t *testing.T) {
+	db, mock, close := repository.GetTestDB()
+	defer close()
+	mock.ExpectExec(trimEvaluationsPattern+".*"+regexp.QuoteMeta("`round_id` = ?)")).
+		WithArgs("round1", 3, "round1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	deleted, err := query.Use(db).Evaluation.RemoveRedundantEvaluation("round1", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if deleted != 2 {
+		t.Errorf("expected 2 deleted evaluations, got %d", deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+func TestTrimRedundantEvaluationsBySubmissionIds(t *testing.T) {
+	db, mock, close := repository.GetTestDB()
+	defer close()
+	// The statistics are already up to date, so nothing else is refreshed
+	mock.ExpectExec("UPDATE `submissions` JOIN").
+		WithArgs("s1", "s2").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(trimEvaluationsPattern+".*"+regexp.QuoteMeta("`submission_id` IN (?,?))")).
+		WithArgs(2, "s1", "s2").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	deleted, err := repository.NewEvaluationRepository().TrimRedundantEvaluations(db, "round1", 2, []types.SubmissionIDType{"s1", "s2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if deleted != 0 {
+		t.Errorf("expected no deleted evaluations, got %d", deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
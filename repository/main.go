@@ -231,7 +231,8 @@ func InitDB(ctx context.Context, testing bool) {
 	db.Exec("ALTER DATABASE s56734__campwiz CHARACTER SET = utf8mb4 COLLATE = utf8mb4_bin;")
 	err = db.AutoMigrate(&models.Project{}, &models.User{}, &models.Campaign{}, &models.Round{},
 		&models.Task{}, &models.Role{}, &models.Submission{},
-		&models.Evaluation{}, &models.TaskData{}, &models.Category{}, &models.Tag{})
+		&models.Evaluation{}, &models.TaskData{}, &models.Category{}, &models.Tag{},
+		&models.RankingPreference{}, &models.ConflictOfInterest{})
 	if err != nil {
 		log.Printf("failed to migrate database %s", err.Error())
 		db.Rollback()
@@ -250,7 +251,8 @@ func InitDB(ctx context.Context, testing bool) {
 	db = conn.Begin()
 	err = db.AutoMigrate(&models.Project{}, &models.User{}, &models.Campaign{}, &models.Round{},
 		&models.Task{}, &models.Role{}, &models.Submission{},
-		&models.Evaluation{}, &models.TaskData{}, &models.Category{}, &models.Tag{})
+		&models.Evaluation{}, &models.TaskData{}, &models.Category{}, &models.Tag{},
+		&models.RankingPreference{}, &models.ConflictOfInterest{})
 
 	if err != nil {
 		log.Printf("failed to migrate database %s", err.Error())
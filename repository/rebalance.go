@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+
+	"gorm.io/gorm"
+)
+
+// Rebalance moves the unevaluated assignments of a round so that no jury has more than its fair share,
+// where the fair share is the total number of assignments divided by the number of juries.
+// The unassigned ones (including the ones of removed juries) are handed to the least loaded jury and the excess of the
+// overloaded juries to the juries below the fair share. A jury never receives a submission it is already
+// assigned to, a submission of its own or a submission it has declared a conflict with.
+// It returns the number of the moved assignments and the number of the ones that remain unassigned.
+func (r *EvaluationRepository) Rebalance(tx *gorm.DB, roundID models.IDType, taskID models.IDType) (moved int, unassigned int, err error) {
+	moves, unassigned, err := r.planRebalance(tx, roundID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(moves) == 0 {
+		return 0, unassigned, nil
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	const batchSize = 1000
+	for judgeID, evaluationIds := range moves {
+		for start := 0; start < len(evaluationIds); start += batchSize {
+			batch := evaluationIds[start:min(start+batchSize, len(evaluationIds))]
+			if _, err := Evaluation.Where(Evaluation.EvaluationID.In(batch...)).Updates(map[string]any{
+				"judge_id":             judgeID,
+				"distribution_task_id": taskID,
+				"skip_count":           0,
+				"skip_expiration_at":   nil,
+			}); err != nil {
+				return 0, 0, err
+			}
+		}
+		moved += len(evaluationIds)
+	}
+	return moved, unassigned, q.JuryStatistics.TriggerByRoundID(roundID.String())
+}
+
+// juryLoad is the number of the assignments of a jury, evaluated or not
+type juryLoad struct {
+	JudgeID models.IDType
+	Count   int
+}
+
+// planRebalance decides where the assignments are moved without writing anything.
+// Only the pending assignments are loaded, the load of the juries is counted by the database.
+// It returns the IDs of the moved evaluations by their new judge and the number of the ones that remain unassigned.
+func (r *EvaluationRepository) planRebalance(tx *gorm.DB, roundID models.IDType) (moves map[models.IDType][]string, unassigned int, err error) {
+	role_repo := NewRoleRepository()
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(tx, &models.RoleFilter{RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		return nil, 0, err
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	loads := []juryLoad{}
+	if err := Evaluation.Select(Evaluation.JudgeID, Evaluation.EvaluationID.Count().As("Count")).
+		Where(Evaluation.RoundID.Eq(roundID.String()), Evaluation.JudgeID.IsNotNull()).
+		Group(Evaluation.JudgeID).Scan(&loads); err != nil {
+		return nil, 0, err
+	}
+	pending, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.SubmissionID, Evaluation.JudgeID).
+		Where(Evaluation.RoundID.Eq(roundID.String()), Evaluation.Score.IsNull(), Evaluation.EvaluatedAt.IsNull()).
+		Order(Evaluation.EvaluationID).Find()
+	if err != nil {
+		return nil, 0, err
+	}
+	load := map[models.IDType]int{}
+	for _, jury := range juries {
+		load[jury.RoleID] = 0
+	}
+	total := 0
+	for _, juryLoad := range loads {
+		// the assignments of the juries who are not in the round anymore are not counted
+		if _, ok := load[juryLoad.JudgeID]; ok {
+			load[juryLoad.JudgeID] = juryLoad.Count
+			total += juryLoad.Count
+		}
+	}
+	orphans := []*models.Evaluation{}
+	movable := map[models.IDType][]*models.Evaluation{}
+	for _, evaluation := range pending {
+		if evaluation.JudgeID == nil {
+			orphans = append(orphans, evaluation)
+			total++
+			continue
+		}
+		if _, ok := load[*evaluation.JudgeID]; !ok {
+			// assigned to a jury who is not in the round anymore, it is handed to the others
+			orphans = append(orphans, evaluation)
+			total++
+			continue
+		}
+		movable[*evaluation.JudgeID] = append(movable[*evaluation.JudgeID], evaluation)
+	}
+	if len(juries) == 0 {
+		return nil, len(orphans), nil
+	}
+	fairShare := (total + len(juries) - 1) / len(juries)
+	// Only the orphans and the assignments of the overloaded juries can move, so only their submissions are looked up
+	candidateSubmissionIds := []string{}
+	seen := map[types.SubmissionIDType]bool{}
+	addCandidates := func(evaluations []*models.Evaluation) {
+		for _, evaluation := range evaluations {
+			if !seen[evaluation.SubmissionID] {
+				seen[evaluation.SubmissionID] = true
+				candidateSubmissionIds = append(candidateSubmissionIds, evaluation.SubmissionID.String())
+			}
+		}
+	}
+	addCandidates(orphans)
+	for _, jury := range juries {
+		if load[jury.RoleID] > fairShare {
+			addCandidates(movable[jury.RoleID])
+		}
+	}
+	if len(candidateSubmissionIds) == 0 {
+		return nil, 0, nil
+	}
+	assigned := map[types.SubmissionIDType]map[models.IDType]bool{}
+	submissionMap := map[types.SubmissionIDType]*models.Submission{}
+	const batchSize = 1000
+	for start := 0; start < len(candidateSubmissionIds); start += batchSize {
+		batch := candidateSubmissionIds[start:min(start+batchSize, len(candidateSubmissionIds))]
+		judges, err := Evaluation.Select(Evaluation.SubmissionID, Evaluation.JudgeID).
+			Where(Evaluation.SubmissionID.In(batch...), Evaluation.JudgeID.IsNotNull()).Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, evaluation := range judges {
+			if assigned[evaluation.SubmissionID] == nil {
+				assigned[evaluation.SubmissionID] = map[models.IDType]bool{}
+			}
+			assigned[evaluation.SubmissionID][*evaluation.JudgeID] = true
+		}
+		submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.SubmittedByID, q.Submission.ParticipantID).
+			Where(q.Submission.SubmissionID.In(batch...)).Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, submission := range submissions {
+			submissionMap[submission.SubmissionID] = submission
+		}
+	}
+	conflicts, err := NewConflictRepository().FetchIndex(tx, roundID)
+	if err != nil {
+		return nil, 0, err
+	}
+	// leastLoaded returns the least loaded jury that can evaluate the submission and has less than the limit
+	leastLoaded := func(submissionID types.SubmissionIDType, limit int) *models.IDType {
+		submission := submissionMap[submissionID]
+		var selected *models.IDType
+		for i := range juries {
+			jury := &juries[i]
+			if load[jury.RoleID] >= limit || assigned[submissionID][jury.RoleID] || conflicts.Conflicts(jury.RoleID, submission) {
+				continue
+			}
+			if submission != nil && (jury.UserID == submission.SubmittedByID || jury.UserID == submission.ParticipantID) {
+				continue
+			}
+			if selected == nil || load[jury.RoleID] < load[*selected] {
+				selected = &jury.RoleID
+			}
+		}
+		return selected
+	}
+	moves = map[models.IDType][]string{}
+	moveTo := func(evaluation *models.Evaluation, judgeID models.IDType) {
+		if evaluation.JudgeID != nil {
+			// the orphans of the removed juries are not counted in the load of anybody
+			if _, ok := load[*evaluation.JudgeID]; ok {
+				load[*evaluation.JudgeID]--
+			}
+			delete(assigned[evaluation.SubmissionID], *evaluation.JudgeID)
+		}
+		load[judgeID]++
+		if assigned[evaluation.SubmissionID] == nil {
+			assigned[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		assigned[evaluation.SubmissionID][judgeID] = true
+		moves[judgeID] = append(moves[judgeID], evaluation.EvaluationID.String())
+	}
+	for _, evaluation := range orphans {
+		judgeID := leastLoaded(evaluation.SubmissionID, total+1)
+		if judgeID == nil {
+			unassigned++
+			continue
+		}
+		moveTo(evaluation, *judgeID)
+	}
+	for _, jury := range juries {
+		for _, evaluation := range movable[jury.RoleID] {
+			if load[jury.RoleID] <= fairShare {
+				break
+			}
+			judgeID := leastLoaded(evaluation.SubmissionID, fairShare)
+			if judgeID == nil {
+				continue
+			}
+			moveTo(evaluation, *judgeID)
+		}
+	}
+	return moves, unassigned, nil
+}
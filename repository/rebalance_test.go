@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// The round has three juries (j1, j2, j3) and a removed one (j9). j1 holds four pending assignments and j9 one pending
+// and one evaluated, s1 has an unassigned one and j2 has declared a conflict with s1. The fair share is two for everyone.
+func TestRebalance(t *testing.T) {
+	db, mock, close := repository.GetTestDB()
+	defer close()
+	// the updates of the juries are issued in no particular order
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectBegin()
+	tx := db.Begin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `roles`")).
+		WillReturnRows(sqlmock.NewRows([]string{"role_id", "type", "user_id", "round_id"}).
+			AddRow("j1", models.RoleTypeJury, "uj1", "r1").
+			AddRow("j2", models.RoleTypeJury, "uj2", "r1").
+			AddRow("j3", models.RoleTypeJury, "uj3", "r1"))
+	// Only the load of each jury is counted, the evaluated assignments are never loaded
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `evaluations`.`judge_id`,COUNT(`evaluations`.`evaluation_id`) AS `Count` FROM `evaluations` WHERE `evaluations`.`round_id` = ? AND `evaluations`.`judge_id` IS NOT NULL GROUP BY `evaluations`.`judge_id`")).
+		WithArgs("r1").
+		WillReturnRows(sqlmock.NewRows([]string{"judge_id", "Count"}).AddRow("j1", 4).AddRow("j9", 2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `evaluations`.`evaluation_id`,`evaluations`.`submission_id`,`evaluations`.`judge_id` FROM `evaluations` WHERE `evaluations`.`round_id` = ? AND `evaluations`.`score` IS NULL AND `evaluations`.`evaluated_at` IS NULL")).
+		WithArgs("r1").
+		WillReturnRows(sqlmock.NewRows([]string{"evaluation_id", "submission_id", "judge_id"}).
+			AddRow("e1", "s1", "j1").
+			AddRow("e2", "s2", "j1").
+			AddRow("e3", "s3", "j1").
+			AddRow("e4", "s4", "j1").
+			AddRow("e5", "s5", "j9").
+			AddRow("e7", "s1", nil))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `evaluations`.`submission_id`,`evaluations`.`judge_id` FROM `evaluations` WHERE `evaluations`.`submission_id` IN (?,?,?,?,?)")).
+		WithArgs("s5", "s1", "s2", "s3", "s4").
+		WillReturnRows(sqlmock.NewRows([]string{"submission_id", "judge_id"}).
+			AddRow("s1", "j1").AddRow("s2", "j1").AddRow("s3", "j1").AddRow("s4", "j1").AddRow("s4", "j9").AddRow("s5", "j9"))
+	submissions := sqlmock.NewRows([]string{"submission_id", "submitted_by_id", "participant_id"})
+	for _, id := range []string{"s1", "s2", "s3", "s4", "s5"} {
+		submissions.AddRow(id, "p1", "p1")
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `submissions` WHERE `submissions`.`submission_id` IN (?,?,?,?,?)")).
+		WithArgs("s5", "s1", "s2", "s3", "s4").
+		WillReturnRows(submissions)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `conflict_of_interests`")).
+		WillReturnRows(sqlmock.NewRows([]string{"conflict_id", "role_id", "round_id", "type", "submission_id"}).
+			AddRow("c1", "j2", "r1", models.ConflictTypeSubmission, "s1"))
+	// The orphan of the removed jury goes to j2, the unassigned one of s1 to j3 as j2 is in conflict with it.
+	// Then j1 hands s2 and s3 over, s1 cannot move as both of the others are ruled out.
+	updateEvaluations := regexp.QuoteMeta("UPDATE `evaluations` SET `distribution_task_id`=?,`judge_id`=?,`skip_count`=?,`skip_expiration_at`=? WHERE `evaluations`.`evaluation_id` IN (?,?)")
+	mock.ExpectExec(updateEvaluations).WithArgs("t1", "j2", 0, nil, "e5", "e2").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(updateEvaluations).WithArgs("t1", "j3", 0, nil, "e7", "e3").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE roles AS jury").WithArgs("r1", "r1", "r1").WillReturnResult(sqlmock.NewResult(0, 3))
+	moved, unassigned, err := repository.NewEvaluationRepository().Rebalance(tx, "r1", "t1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if moved != 4 || unassigned != 0 {
+		t.Errorf("expected 4 moved and none unassigned, got %d moved and %d unassigned", moved, unassigned)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -119,3 +119,31 @@ func (r *RoundRepository) UpdateFullStatisticsByRoundID(conn *gorm.DB, roundID m
 	}
 	return q.SubmissionStatistics.TriggerByRoundId(roundID.String())
 }
+
+// FetchJudgeScores returns all the scores given by the juries in a round
+func (r *RoundRepository) FetchJudgeScores(conn *gorm.DB, roundID models.IDType) (scores []models.JudgeScore, err error) {
+	scores = []models.JudgeScore{}
+	q := query.Use(conn)
+	Evaluation := q.Evaluation
+	err = Evaluation.Select(Evaluation.JudgeID, Evaluation.SubmissionID, Evaluation.Score).
+		Where(Evaluation.RoundID.Eq(roundID.String()), Evaluation.Score.IsNotNull(), Evaluation.JudgeID.IsNotNull()).
+		Scan(&scores)
+	return scores, err
+}
+
+// TrimRedundantEvaluations deletes the pending assignments of all the submissions of a round that have already
+// reached the quorum, then refreshes the statistics of the submissions, the juries and the round.
+func (r *RoundRepository) TrimRedundantEvaluations(conn *gorm.DB, round *models.Round) (int, error) {
+	q := query.Use(conn)
+	if err := q.SubmissionStatistics.TriggerByRoundId(round.RoundID.String()); err != nil {
+		return 0, err
+	}
+	deleted, err := q.Evaluation.RemoveRedundantEvaluation(round.RoundID.String(), int(round.Quorum))
+	if err != nil {
+		return 0, err
+	}
+	if err := q.SubmissionStatistics.TriggerByRoundId(round.RoundID.String()); err != nil {
+		return 0, err
+	}
+	return int(deleted), r.UpdateStatisticsByRoundID(conn, round.RoundID)
+}
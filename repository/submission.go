@@ -118,6 +118,13 @@ func (r *SubmissionRepository) FindNextUnevaluatedSubmissionForPublicJury(tx *go
 			includeNonEvaluated = *filter.IncludeNonEvaluated
 		}
 	}
+	if filter.JuryRoleID != "" {
+		// The juries must never get their own submissions
+		jury, err := q.Role.Select(q.Role.UserID).Where(q.Role.RoleID.Eq(filter.JuryRoleID.String())).First()
+		if err == nil {
+			stmt = stmt.Where(Submission.SubmittedByID.Neq(jury.UserID.String()), Submission.ParticipantID.Neq(jury.UserID.String()))
+		}
+	}
 	if includeEvaluated != includeNonEvaluated {
 		if includeEvaluated {
 			stmt = stmt.Where(Submission.SubmissionID.In(alreadyCoveredSubmissionIDs...))
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+
+	"gorm.io/gorm"
+)
+
+// UndoDistribution unassigns the unevaluated evaluations that a finished distribution (or swap) task has moved.
+// The distributions only attribute the assignments they have moved to the task, so the ones a jury kept are left as is.
+// A round that is still distributing gets back the status it had before the task, or is paused if that is unknown.
+// It returns the number of the unassigned evaluations.
+func (r *TaskRepository) UndoDistribution(tx *gorm.DB, round *models.Round, task *models.Task) (int, error) {
+	if task.AssociatedRoundID == nil || *task.AssociatedRoundID != round.RoundID {
+		return 0, errors.New("task does not belong to the round")
+	}
+	if task.Type != models.TaskTypeDistributeEvaluations && task.Type != models.TaskTypeSwapAssignments {
+		return 0, errors.New("only the distribution and the swap tasks can be undone")
+	}
+	if task.Status != models.TaskStatusSuccess && task.Status != models.TaskStatusFailed {
+		return 0, errors.New("the task has not finished yet")
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	res, err := Evaluation.Where(Evaluation.RoundID.Eq(round.RoundID.String()), Evaluation.DistributionTaskID.Eq(task.TaskID.String()),
+		Evaluation.Score.IsNull(), Evaluation.EvaluatedAt.IsNull()).Updates(map[string]any{
+		"judge_id":             nil,
+		"distribution_task_id": nil,
+		"skip_count":           0,
+		"skip_expiration_at":   nil,
+	})
+	if err != nil {
+		return 0, err
+	}
+	roundUpdates := map[string]any{}
+	if round.Status == models.RoundStatusDistributing {
+		status := models.RoundStatusPaused
+		TaskData := q.TaskData
+		recorded, err := TaskData.Where(TaskData.TaskID.Eq(task.TaskID.String()), TaskData.Key.Eq(models.RoundStatusTaskDataKey)).Limit(1).Find()
+		if err != nil {
+			return 0, err
+		}
+		if len(recorded) > 0 && recorded[0].Value != "" && models.RoundStatus(recorded[0].Value) != models.RoundStatusDistributing {
+			status = models.RoundStatus(recorded[0].Value)
+		}
+		roundUpdates["status"] = status
+	}
+	if round.LatestDistributionTaskID != nil && *round.LatestDistributionTaskID == task.TaskID {
+		roundUpdates["latest_distribution_task_id"] = nil
+	}
+	if len(roundUpdates) > 0 {
+		if res := tx.Model(&models.Round{RoundID: round.RoundID}).Updates(roundUpdates); res.Error != nil {
+			return 0, res.Error
+		}
+	}
+	if err := NewRoundRepository().UpdateStatisticsByRoundID(tx, round.RoundID); err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected), nil
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUndoDistributionUnfinishedTask(t *testing.T) {
+	db, mock, close := repository.GetTestDB()
+	defer close()
+	roundID := models.IDType("round1")
+	round := &models.Round{RoundID: roundID, Status: models.RoundStatusPaused}
+	for _, status := range []models.TaskStatus{models.TaskStatusPending, models.TaskStatusRunning} {
+		task := &models.Task{TaskID: "task1", Type: models.TaskTypeDistributeEvaluations, Status: status, AssociatedRoundID: &roundID}
+		if _, err := repository.NewTaskRepository().UndoDistribution(db, round, task); err == nil {
+			t.Errorf("expected a %s task to be rejected", status)
+		}
+	}
+	// nothing must be touched
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+func TestUndoDistributionOtherTask(t *testing.T) {
+	db, _, close := repository.GetTestDB()
+	defer close()
+	roundID := models.IDType("round1")
+	otherRoundID := models.IDType("round2")
+	round := &models.Round{RoundID: roundID, Status: models.RoundStatusPaused}
+	tasks := []*models.Task{
+		{TaskID: "task1", Type: models.TaskTypeImportFromCommons, Status: models.TaskStatusSuccess, AssociatedRoundID: &roundID},
+		{TaskID: "task2", Type: models.TaskTypeDistributeEvaluations, Status: models.TaskStatusSuccess, AssociatedRoundID: &otherRoundID},
+	}
+	for _, task := range tasks {
+		if _, err := repository.NewTaskRepository().UndoDistribution(db, round, task); err == nil {
+			t.Errorf("expected the task %s to be rejected", task.TaskID)
+		}
+	}
+}
+func TestUndoDistributionRestoresRoundStatus(t *testing.T) {
+	db, mock, close := repository.GetTestDB()
+	defer close()
+	roundID := models.IDType("round1")
+	taskID := models.IDType("task1")
+	round := &models.Round{RoundID: roundID, Status: models.RoundStatusDistributing, LatestDistributionTaskID: &taskID}
+	task := &models.Task{TaskID: taskID, Type: models.TaskTypeDistributeEvaluations, Status: models.TaskStatusFailed, AssociatedRoundID: &roundID}
+	mock.ExpectBegin()
+	tx := db.Begin()
+	// Only the pending assignments attributed to the task are unassigned
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `evaluations` SET `distribution_task_id`=?,`judge_id`=?,`skip_count`=?,`skip_expiration_at`=? WHERE `evaluations`.`round_id` = ? AND `evaluations`.`distribution_task_id` = ? AND `evaluations`.`score` IS NULL AND `evaluations`.`evaluated_at` IS NULL")).
+		WithArgs(nil, nil, 0, nil, roundID, taskID).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_data` WHERE `task_data`.`task_id` = ? AND `task_data`.`key` = ? LIMIT ?")).
+		WithArgs(taskID, models.RoundStatusTaskDataKey, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"data_id", "task_id", "key", "value"}).AddRow("d1", taskID, models.RoundStatusTaskDataKey, models.RoundStatusScheduled))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `rounds` SET `latest_distribution_task_id`=?,`status`=?")).
+		WithArgs(nil, models.RoundStatusScheduled, roundID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE rounds").WithArgs(roundID, roundID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE roles AS jury").WithArgs(roundID, roundID, roundID).WillReturnResult(sqlmock.NewResult(0, 2))
+	unassigned, err := repository.NewTaskRepository().UndoDistribution(tx, round, task)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if unassigned != 3 {
+		t.Errorf("expected 3 unassigned evaluations, got %d", unassigned)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
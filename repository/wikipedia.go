@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"nokib/campwiz/models"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var wikiLanguagePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,15}$`)
+
+// Patterns of the wikitext markup which does not count as words
+var (
+	wikitextCommentPattern  = regexp.MustCompile(`(?s)<!--.*?-->`)
+	wikitextRefPattern      = regexp.MustCompile(`(?is)<ref[^>/]*/>|<ref[^>]*>.*?</ref>`)
+	wikitextTemplatePattern = regexp.MustCompile(`\{\{[^{}]*\}\}|\{\|[^{}]*\|\}`)
+	wikitextLinkPattern     = regexp.MustCompile(`\[\[(?:[^\[\]|]*\|)?([^\[\]]*)\]\]`)
+	wikitextTagPattern      = regexp.MustCompile(`<[^>]+>|'{2,}|={2,}|\[https?://[^\s\]]*|__[A-Z]+__`)
+)
+
+// WikipediaRepository reads the articles and their revisions from a language edition of Wikipedia
+type WikipediaRepository struct {
+	*CommonsRepository
+	Language string
+}
+
+type createLogEvent struct {
+	Title     string    `json:"title"`
+	Ns        int       `json:"ns"`
+	Timestamp time.Time `json:"timestamp"`
+}
+type createLogQueryResponse = BaseQueryResponse[struct {
+	LogEvents []createLogEvent `json:"logevents"`
+}, map[string]string]
+
+type revisionListPage struct {
+	models.Page
+	Missing   *string           `json:"missing"`
+	Revisions []models.Revision `json:"revisions"`
+}
+
+// NewWikipediaRepository returns a repository for the Wikipedia of the language (e.g. `en`, `bn`)
+func NewWikipediaRepository(language string, cl *http.Client) (*WikipediaRepository, error) {
+	if !wikiLanguagePattern.MatchString(language) {
+		return nil, fmt.Errorf("invalid wiki language: %s", language)
+	}
+	if cl == nil {
+		cl = &http.Client{}
+	}
+	return &WikipediaRepository{
+		CommonsRepository: &CommonsRepository{
+			endpoint: fmt.Sprintf("https://%s.wikipedia.org/w/api.php", language),
+			cl:       cl,
+		},
+		Language: language,
+	}, nil
+}
+
+// ArticleURL returns the URL of the article on the wiki
+func (w *WikipediaRepository) ArticleURL(title string) string {
+	return fmt.Sprintf("https://%s.wikipedia.org/wiki/%s", w.Language, url.PathEscape(strings.ReplaceAll(title, " ", "_")))
+}
+
+func (w *WikipediaRepository) query(params url.Values, response any) error {
+	stream, err := w.Get(params)
+	if err != nil {
+		return err
+	}
+	defer stream.Close() //nolint:errcheck
+	if err := json.NewDecoder(stream).Decode(response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// ListCreatedArticles returns the titles of the articles (main namespace) created within the window, oldest first
+func (w *WikipediaRepository) ListCreatedArticles(start time.Time, end time.Time) ([]string, error) {
+	params := url.Values{
+		"action":      {"query"},
+		"format":      {"json"},
+		"list":        {"logevents"},
+		"letype":      {"create"},
+		"lenamespace": {"0"},
+		"ledir":       {"newer"},
+		"lestart":     {start.UTC().Format(time.RFC3339)},
+		"leend":       {end.UTC().Format(time.RFC3339)},
+		"leprop":      {"title|timestamp"},
+		"lelimit":     {"max"},
+		"continue":    {""},
+	}
+	titles := []string{}
+	for {
+		response := &createLogQueryResponse{}
+		if err := w.query(params, response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("error from wikipedia API: %s - %s", response.Error.Code, response.Error.Info)
+		}
+		for _, event := range response.Query.LogEvents {
+			titles = append(titles, event.Title)
+		}
+		if response.Next == nil {
+			break
+		}
+		for key, value := range *response.Next {
+			params.Set(key, value)
+		}
+	}
+	log.Printf("Found %d articles created on %s.wikipedia between %s and %s", len(titles), w.Language, start, end)
+	return titles, nil
+}
+
+// fetchRevisions returns the page along with its revisions matching the parameters,
+// following the continuation only if all of them are needed
+func (w *WikipediaRepository) fetchRevisions(params url.Values, all bool) (*revisionListPage, error) {
+	var result *revisionListPage
+	for {
+		response := &PageQueryResponse[revisionListPage]{}
+		if err := w.query(params, response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("error from wikipedia API: %s - %s", response.Error.Code, response.Error.Info)
+		}
+		for _, page := range response.Query.Pages {
+			if page.Missing != nil {
+				return nil, fmt.Errorf("page not found: %s", page.Title)
+			}
+			if result == nil {
+				result = &page
+			} else {
+				result.Revisions = append(result.Revisions, page.Revisions...)
+			}
+		}
+		if response.Next == nil || !all {
+			break
+		}
+		for key, value := range *response.Next {
+			params.Set(key, value)
+		}
+	}
+	if result == nil {
+		return nil, fmt.Errorf("page not found: %s", params.Get("titles"))
+	}
+	return result, nil
+}
+
+// fetchRevisionWords returns the number of the words of the content of the revisions
+func (w *WikipediaRepository) fetchRevisionWords(revids ...uint64) (map[uint64]uint64, error) {
+	ids := []string{}
+	for _, revid := range revids {
+		ids = append(ids, fmt.Sprint(revid))
+	}
+	params := url.Values{
+		"action":  {"query"},
+		"format":  {"json"},
+		"prop":    {"revisions"},
+		"revids":  {strings.Join(ids, "|")},
+		"rvprop":  {"ids|content"},
+		"rvslots": {"main"},
+	}
+	response := &PageQueryResponse[revisionListPage]{}
+	if err := w.query(params, response); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("error from wikipedia API: %s - %s", response.Error.Code, response.Error.Info)
+	}
+	words := map[uint64]uint64{}
+	for _, page := range response.Query.Pages {
+		for _, revision := range page.Revisions {
+			if revision.Slots != nil {
+				words[revision.Revid] = CountWikitextWords(string(revision.Slots.Main.Content))
+			}
+		}
+	}
+	return words, nil
+}
+
+// CountWikitextWords counts the words of the prose of a wikitext, i.e. without the comments, references,
+// templates, tables and the markup. The targets of the links are not counted, only their labels.
+func CountWikitextWords(wikitext string) uint64 {
+	text := wikitextCommentPattern.ReplaceAllString(wikitext, " ")
+	text = wikitextRefPattern.ReplaceAllString(text, " ")
+	// The templates and the tables are nested, so remove them from the innermost one
+	for {
+		stripped := wikitextTemplatePattern.ReplaceAllString(text, " ")
+		if stripped == text {
+			break
+		}
+		text = stripped
+	}
+	for {
+		stripped := wikitextLinkPattern.ReplaceAllString(text, "$1")
+		if stripped == text {
+			break
+		}
+		text = stripped
+	}
+	text = wikitextTagPattern.ReplaceAllString(text, " ")
+	count := uint64(0)
+	for _, word := range strings.Fields(text) {
+		if strings.IndexFunc(word, func(r rune) bool { return !strings.ContainsRune("*#:;|!-=[]{}", r) }) != -1 {
+			count++
+		}
+	}
+	return count
+}
+
+// GetArticleResult computes the contribution to the article within the window.
+// An article is new if it had no revision before the window, in which case the creator is the participant,
+// otherwise the participant is the user who added the most bytes within the window.
+// The added bytes and words are the difference between the last revision within the window and the last one before it.
+func (w *WikipediaRepository) GetArticleResult(title string, start time.Time, end time.Time) (*models.MediaResult, error) {
+	// rvstart is inclusive, so the baseline ends a second before the window to leave the revisions made at its very start to it
+	baseline, err := w.fetchRevisions(url.Values{
+		"action":   {"query"},
+		"format":   {"json"},
+		"prop":     {"revisions"},
+		"titles":   {title},
+		"rvprop":   {"ids|timestamp|user|size"},
+		"rvdir":    {"older"},
+		"rvstart":  {start.Add(-time.Second).UTC().Format(time.RFC3339)},
+		"rvlimit":  {"1"},
+		"continue": {""},
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	window, err := w.fetchRevisions(url.Values{
+		"action":   {"query"},
+		"format":   {"json"},
+		"prop":     {"revisions"},
+		"titles":   {title},
+		"rvprop":   {"ids|timestamp|user|size"},
+		"rvdir":    {"newer"},
+		"rvstart":  {start.UTC().Format(time.RFC3339)},
+		"rvend":    {end.UTC().Format(time.RFC3339)},
+		"rvlimit":  {"max"},
+		"continue": {""},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(window.Revisions) == 0 {
+		return nil, fmt.Errorf("no edits within the window: %s", title)
+	}
+	isNew := len(baseline.Revisions) == 0
+	previousSize := uint64(0)
+	revids := []uint64{}
+	if !isNew {
+		previousSize = baseline.Revisions[0].Size
+		revids = append(revids, baseline.Revisions[0].Revid)
+	}
+	startSize := previousSize
+	addedByUser := map[models.WikimediaUsernameType]int64{}
+	for _, revision := range window.Revisions {
+		addedByUser[revision.User] += int64(revision.Size) - int64(previousSize)
+		previousSize = revision.Size
+	}
+	first, last := window.Revisions[0], window.Revisions[len(window.Revisions)-1]
+	revids = append(revids, last.Revid)
+	participant := first.User
+	submittedAt := first.Timestamp
+	if !isNew {
+		for user, added := range addedByUser {
+			if added > addedByUser[participant] || (added == addedByUser[participant] && user < participant) {
+				participant = user
+			}
+		}
+		submittedAt = last.Timestamp
+	}
+	words, err := w.fetchRevisionWords(revids...)
+	if err != nil {
+		return nil, err
+	}
+	article := &models.ArticleSubmission{
+		Language:     w.Language,
+		TotalBytes:   last.Size,
+		TotalWords:   words[last.Revid],
+		IsNewArticle: isNew,
+	}
+	if last.Size > startSize {
+		article.AddedBytes = last.Size - startSize
+	}
+	startWords := uint64(0)
+	if !isNew {
+		startWords = words[baseline.Revisions[0].Revid]
+	}
+	if article.TotalWords > startWords {
+		article.AddedWords = article.TotalWords - startWords
+	}
+	return &models.MediaResult{
+		PageID:              uint64(window.PageID),
+		Name:                window.Title,
+		URL:                 w.ArticleURL(window.Title),
+		SubmittedAt:         submittedAt,
+		CreatedByUsername:   participant,
+		SubmittedByUsername: participant,
+		Size:                last.Size,
+		MediaType:           string(models.MediaTypeArticle),
+		Article:             article,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package repository_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCountWikitextWords(t *testing.T) {
+	cases := map[string]uint64{
+		"":                      0,
+		"Dhaka is the capital.": 4,
+		"'''Dhaka''' is the [[capital city|capital]] of [[Bangladesh]].":             6,
+		"{{Infobox city|name={{lang|bn|ঢাকা}}}}Dhaka is a city.":                     4,
+		"Dhaka<ref>{{cite web|title=Dhaka}}</ref> is a city.<!-- hidden comment -->": 4,
+		"== History ==\n* Founded\n* Grew":                                           3,
+	}
+	for wikitext, expected := range cases {
+		if got := repository.CountWikitextWords(wikitext); got != expected {
+			t.Errorf("CountWikitextWords(%q) = %d, expected %d", wikitext, got, expected)
+		}
+	}
+}
+
+// stubWikipedia answers the revision queries of an article from canned revisions
+type stubWikipedia struct {
+	t        *testing.T
+	start    time.Time
+	baseline []map[string]any
+	window   []map[string]any
+	contents map[string]string
+}
+
+func (s *stubWikipedia) RoundTrip(req *http.Request) (*http.Response, error) {
+	params := req.URL.Query()
+	page := map[string]any{"pageid": 42, "ns": 0, "title": "Dhaka"}
+	switch {
+	case params.Get("revids") != "":
+		revisions := []map[string]any{}
+		for _, revid := range strings.Split(params.Get("revids"), "|") {
+			id, _ := strconv.ParseUint(revid, 10, 64)
+			revisions = append(revisions, map[string]any{
+				"revid": id,
+				"slots": map[string]any{"main": map[string]any{"contentmodel": "wikitext", "*": s.contents[revid]}},
+			})
+		}
+		page["revisions"] = revisions
+	case params.Get("rvdir") == "older":
+		if expected := s.start.Add(-time.Second).UTC().Format(time.RFC3339); params.Get("rvstart") != expected {
+			s.t.Errorf("expected the baseline to start at %s, got %s", expected, params.Get("rvstart"))
+		}
+		page["revisions"] = s.baseline
+	case params.Get("rvdir") == "newer":
+		if expected := s.start.UTC().Format(time.RFC3339); params.Get("rvstart") != expected {
+			s.t.Errorf("expected the window to start at %s, got %s", expected, params.Get("rvstart"))
+		}
+		page["revisions"] = s.window
+	default:
+		s.t.Errorf("unexpected request: %s", req.URL)
+	}
+	body, err := json.Marshal(map[string]any{"batchcomplete": "", "query": map[string]any{"pages": map[string]any{"42": page}}})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(body)), Header: http.Header{}, Request: req}, nil
+}
+
+func revision(revid uint64, user string, timestamp time.Time, size uint64) map[string]any {
+	return map[string]any{"revid": revid, "user": user, "timestamp": timestamp.Format(time.RFC3339), "size": size}
+}
+
+func TestGetArticleResult(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name        string
+		baseline    []map[string]any
+		window      []map[string]any
+		contents    map[string]string
+		isNew       bool
+		participant models.WikimediaUsernameType
+		totalBytes  uint64
+		addedBytes  uint64
+		totalWords  uint64
+		addedWords  uint64
+	}{
+		{
+			name: "new article",
+			// the creation is exactly at the start of the window
+			window: []map[string]any{
+				revision(11, "Alice", start, 100),
+				revision(12, "Bob", start.Add(time.Hour), 400),
+			},
+			contents:    map[string]string{"12": "Dhaka is the capital of Bangladesh."},
+			isNew:       true,
+			participant: "Alice",
+			totalBytes:  400,
+			addedBytes:  400,
+			totalWords:  6,
+			addedWords:  6,
+		},
+		{
+			name:     "expanded article",
+			baseline: []map[string]any{revision(10, "Old", start.Add(-24*time.Hour), 1000)},
+			window: []map[string]any{
+				revision(11, "Alice", start, 1050),
+				revision(12, "Bob", start.Add(time.Hour), 1350),
+				revision(13, "Alice", start.Add(2*time.Hour), 1330),
+			},
+			contents:    map[string]string{"10": "Dhaka is a city.", "13": "Dhaka is a big and old city."},
+			participant: "Bob",
+			totalBytes:  1330,
+			addedBytes:  330,
+			totalWords:  7,
+			addedWords:  3,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			stub := &stubWikipedia{t: t, start: start, baseline: c.baseline, window: c.window, contents: c.contents}
+			wiki, err := repository.NewWikipediaRepository("en", &http.Client{Transport: stub})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			result, err := wiki.GetArticleResult("Dhaka", start, end)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if result.Article == nil {
+				t.Fatal("expected the article information")
+			}
+			if result.Article.IsNewArticle != c.isNew {
+				t.Errorf("expected IsNewArticle to be %t", c.isNew)
+			}
+			if result.CreatedByUsername != c.participant || result.SubmittedByUsername != c.participant {
+				t.Errorf("expected the participant %s, got %s", c.participant, result.CreatedByUsername)
+			}
+			if result.Article.TotalBytes != c.totalBytes || result.Article.AddedBytes != c.addedBytes {
+				t.Errorf("expected %d bytes with %d added, got %d with %d added", c.totalBytes, c.addedBytes, result.Article.TotalBytes, result.Article.AddedBytes)
+			}
+			if result.Article.TotalWords != c.totalWords || result.Article.AddedWords != c.addedWords {
+				t.Errorf("expected %d words with %d added, got %d with %d added", c.totalWords, c.addedWords, result.Article.TotalWords, result.Article.AddedWords)
+			}
+		})
+	}
+}
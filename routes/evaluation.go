@@ -82,6 +82,39 @@ func UpdateEvaluation(c *gin.Context, sess *cache.Session) {
 	c.JSON(200, models.ResponseSingle[models.Evaluation]{Data: *evaluation})
 }
 
+// SkipEvaluation godoc
+// @Summary Skip an evaluation
+// @Description Hide an assigned evaluation from the jury until the skip expires
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Evaluation]
+// @Router /evaluation/{evaluationId}/skip [post]
+// @Tags Evaluation
+// @Param evaluationId path string true "The evaluation ID"
+// @Param skipRequest body models.SkipRequest false "The skip request"
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func SkipEvaluation(c *gin.Context, sess *cache.Session) {
+	evaluationId := c.Param("evaluationId")
+	if evaluationId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Evaluation ID is required"})
+		return
+	}
+	req := &models.SkipRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(req); err != nil {
+			c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+			return
+		}
+	}
+	evaluation_service := services.NewEvaluationService()
+	evaluation, err := evaluation_service.SkipEvaluation(c, sess.UserID, models.IDType(evaluationId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error skipping evaluation : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[models.Evaluation]{Data: *evaluation})
+}
+
 // Bulk Evaluate godoc
 // @Summary Bulk evaluate
 // @Description Bulk evaluate
@@ -215,3 +248,67 @@ func GetEvaluation(c *gin.Context, sess *cache.Session) {
 	}
 	c.JSON(200, models.ResponseSingle[models.Evaluation]{Data: *evaluation})
 }
+
+// SubmitRanking godoc
+// @Summary Submit the ranking of a jury
+// @Description Submit the ordered list (best first) of the submissions assigned to the jury in a ranking round
+// @Produce  json
+// @Success 200 {object} models.ResponseList[models.Evaluation]
+// @Router /evaluation/ranking/{roundId} [post]
+// @Tags Evaluation
+// @Param roundId path string true "The round ID"
+// @Param rankingRequest body models.RankingRequest true "The ranking request"
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func SubmitRanking(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &models.RankingRequest{}
+	err := c.ShouldBindJSON(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	evaluation_service := services.NewEvaluationService()
+	evaluations, err := evaluation_service.SubmitRanking(c, sess.UserID, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error submitting ranking : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseList[*models.Evaluation]{Data: evaluations})
+}
+
+// SubmitPairwisePreferences godoc
+// @Summary Submit pairwise preferences of a jury
+// @Description Submit the pairwise preferences between the submissions assigned to the jury in a ranking round
+// @Produce  json
+// @Success 200 {object} models.ResponseList[models.RankingPreference]
+// @Router /evaluation/ranking/{roundId}/pairwise [post]
+// @Tags Evaluation
+// @Param roundId path string true "The round ID"
+// @Param pairwisePreferenceRequest body models.PairwisePreferenceRequest true "The pairwise preferences"
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func SubmitPairwisePreferences(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &models.PairwisePreferenceRequest{}
+	err := c.ShouldBindJSON(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	evaluation_service := services.NewEvaluationService()
+	preferences, err := evaluation_service.SubmitPairwisePreferences(c, sess.UserID, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error submitting preferences : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseList[models.RankingPreference]{Data: preferences})
+}
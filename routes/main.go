@@ -41,8 +41,15 @@ func NewRoundRoutes(parent *gin.RouterGroup) {
 	r.GET("/:roundId/next/public", WithSession(NextPublicSubmission))
 	r.GET("/:roundId/results/summary", WithSession(GetResultSummary))
 	r.GET("/:roundId/results/:format", WithSession(GetResults))
+	r.GET("/:roundId/reliability", WithSession(GetReliabilityReport))
 	r.POST("/:roundId/status", WithSession(UpdateStatus))
 	r.POST("/:roundId/randomize", WithSession(Randomize))
+	r.POST("/:roundId/swap", WithSession(SwapAssignments))
+	r.GET("/:roundId/conflicts", WithSession(ListConflicts))
+	r.POST("/:roundId/conflicts", WithSession(DeclareConflicts))
+	r.POST("/:roundId/capacities", WithSession(SetJuryCapacities))
+	r.POST("/:roundId/undo/:taskId", WithSession(UndoDistribution))
+	r.POST("/:roundId/trim", WithSession(TrimRedundantEvaluations))
 	r.POST("/", WithSession(CreateRound))
 	r.POST("/:roundId", WithSession(UpdateRoundDetails))
 	r.POST("/import/:roundId/commons", WithSession(ImportFromCommons))
@@ -50,7 +57,12 @@ func NewRoundRoutes(parent *gin.RouterGroup) {
 	r.POST("/import/:roundId/csv", WithSession(ImportFromCSV))
 	r.POST("/import/:roundId/fountain", WithSession(ImportFromFountain))
 	r.POST("/import/:roundId/campwizv1", WithSession(ImportFromCampWizV1))
+	r.POST("/import/:roundId/pagepile", WithSession(ImportFromPagePile))
+	r.POST("/import/:roundId/petscan", WithSession(ImportFromPetScan))
+	r.POST("/import/:roundId/uploaders", WithSession(ImportFromUploaders))
+	r.POST("/import/:roundId/wikipedia", WithSession(ImportFromWikipedia))
 	r.POST("/distribute/:roundId", WithSession(DistributeEvaluations))
+	r.POST("/distribute/:roundId/preview", WithSession(PreviewDistribution))
 
 }
 func NewSubmissionRoutes(parent *gin.RouterGroup) {
@@ -111,8 +123,11 @@ func NewEvaluationRoutes(r *gin.RouterGroup) {
 	route.POST("/", WithSession(BulkEvaluate))
 	route.GET("/:evaluationId", WithSession(GetEvaluation))
 	route.POST("/:evaluationId", WithSession(UpdateEvaluation))
+	route.POST("/:evaluationId/skip", WithSession(SkipEvaluation))
 	route.POST("/public/:roundId/:submissionId", WithSession(SubmitNewPublicEvaluation))
 	route.POST("/public/:roundId", WithSession(SubmitNewBulkPublicEvaluation))
+	route.POST("/ranking/:roundId", WithSession(SubmitRanking))
+	route.POST("/ranking/:roundId/pairwise", WithSession(SubmitPairwisePreferences))
 }
 
 func NewProjectRoutes(parent *gin.RouterGroup) *gin.RouterGroup {
@@ -45,7 +45,18 @@ func NewReadOnlyRoundRoutes(parent *gin.RouterGroup) {
 	r.POST("/:roundId", ReadOnlyMode)
 	r.POST("/import/:roundId/commons", ReadOnlyMode)
 	r.POST("/import/:roundId/previous", ReadOnlyMode)
+	r.POST("/import/:roundId/pagepile", ReadOnlyMode)
+	r.POST("/import/:roundId/petscan", ReadOnlyMode)
+	r.POST("/import/:roundId/uploaders", ReadOnlyMode)
+	r.POST("/import/:roundId/wikipedia", ReadOnlyMode)
 	r.POST("/distribute/:roundId", ReadOnlyMode)
+	r.POST("/distribute/:roundId/preview", WithSession(PreviewDistribution))
+	r.POST("/:roundId/swap", ReadOnlyMode)
+	r.GET("/:roundId/conflicts", WithSession(ListConflicts))
+	r.POST("/:roundId/conflicts", ReadOnlyMode)
+	r.POST("/:roundId/capacities", ReadOnlyMode)
+	r.POST("/:roundId/undo/:taskId", ReadOnlyMode)
+	r.POST("/:roundId/trim", ReadOnlyMode)
 }
 
 func NewReadOnlySubmissionRoutes(parent *gin.RouterGroup) {
@@ -105,6 +116,7 @@ func NewReadOnlyEvaluationRoutes(r *gin.RouterGroup) {
 	route.GET("/", WithSession(ListEvaluations))
 	route.POST("/", ReadOnlyMode)
 	route.POST("/:evaluationId", ReadOnlyMode)
+	route.POST("/:evaluationId/skip", ReadOnlyMode)
 	route.POST("/public/:roundId/:submissionId", ReadOnlyMode)
 	route.POST("/public/:roundId", ReadOnlyMode)
 }
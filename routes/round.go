@@ -79,6 +79,7 @@ func ListAllRounds(c *gin.Context, sess *cache.Session) {
 // ImportFromCommons godoc
 // @Summary Import images from commons
 // @Description The user would provide a round ID and a list of commons categories and the system would import images from those categories
+// @Description and optionally from their subcategories up to the given depth, skipping the excluded categories
 // @Produce  json
 // @Success 200 {object} models.ResponseSingle[models.Task]
 // @Router /round/import/{roundId}/commons [post]
@@ -103,7 +104,7 @@ func ImportFromCommons(c *gin.Context, sess *cache.Session) {
 		c.JSON(400, models.ResponseError{Detail: "Invalid request : No categories provided"})
 		return
 	}
-	task, err := round_service.ImportFromCommons(c, models.IDType(roundId), req.Categories)
+	task, err := round_service.ImportFromCommons(c, models.IDType(roundId), req)
 	if err != nil {
 		c.JSON(400, models.ResponseError{Detail: "Failed to import images : " + err.Error()})
 		return
@@ -199,6 +200,118 @@ func ImportFromCSV(c *gin.Context, sess *cache.Session) {
 	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
 }
 
+// ImportFromPetScan godoc
+// @Summary Import images from PetScan
+// @Description The user would provide a round ID and either a PetScan JSON result or the PSID of an exported result and the system would import the listed files
+// @Consumes multipart/form-data
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/import/{roundId}/petscan [post]
+// @Param roundId path string true "The round ID"
+// @Param ImportFromPetScanRequest formData services.ImportFromPetScanRequest true "The import from PetScan request"
+// @Param file formData file false "The PetScan JSON result (upto 50MB)"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func ImportFromPetScan(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &services.ImportFromPetScanRequest{}
+	err := c.ShouldBind(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error Decoding : " + err.Error()})
+		return
+	}
+	if req.File == nil && req.PSID <= 0 {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Either a file or a PSID is required"})
+		return
+	}
+	if req.File != nil && req.File.Size > consts.MAX_PETSCAN_FILE_SIZE {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : File size exceeds " + fmt.Sprintf("%dMB", consts.MAX_PETSCAN_FILE_SIZE>>20)})
+		return
+	}
+
+	round_service := services.NewRoundService()
+	task, err := round_service.ImportFromPetScan(c, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to import images : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
+// ImportFromUploaders godoc
+// @Summary Import images by uploaders
+// @Description The user would provide a round ID and a list of usernames and the system would import every file uploaded by those users within the date window
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/import/{roundId}/uploaders [post]
+// @Param roundId path string true "The round ID"
+// @Param ImportFromUploadersPayload body services.ImportFromUploadersPayload true "The import by uploaders request"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func ImportFromUploaders(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &services.ImportFromUploadersPayload{}
+	err := c.ShouldBindBodyWithJSON(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error Decoding : " + err.Error()})
+		return
+	}
+	if len(req.Usernames) == 0 {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : No usernames provided"})
+		return
+	}
+	round_service := services.NewRoundService()
+	task, err := round_service.ImportFromUploaders(c, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to import images : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
+// ImportFromWikipedia godoc
+// @Summary Import articles from Wikipedia
+// @Description The user would provide a round ID, a wiki language and either a list of titles or a date window and the system would import the articles
+// @Description along with whether they were created or expanded and the bytes and the words added within the window
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/import/{roundId}/wikipedia [post]
+// @Param roundId path string true "The round ID"
+// @Param ImportFromWikipediaPayload body services.ImportFromWikipediaPayload true "The import from Wikipedia request"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func ImportFromWikipedia(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &services.ImportFromWikipediaPayload{}
+	err := c.ShouldBindBodyWithJSON(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error Decoding : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	task, err := round_service.ImportFromWikipedia(c, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to import articles : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
 type ImportFromFountainRequest struct {
 	Code string `json:"code" binding:"required"`
 }
@@ -241,6 +354,47 @@ func ImportFromFountain(c *gin.Context, sess *cache.Session) {
 	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
 }
 
+type ImportFromPagePileRequest struct {
+	PagePileID int64 `json:"pagePileId" binding:"required"`
+}
+
+// ImportFromPagePile godoc
+// @Summary Import images from PagePile
+// @Description The user would provide a round ID and a PagePile ID and the system would import the files listed in that pile
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/import/{roundId}/pagepile [post]
+// @Param roundId path string true "The round ID"
+// @Param ImportFromPagePileRequest body ImportFromPagePileRequest true "The import from PagePile request"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func ImportFromPagePile(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &ImportFromPagePileRequest{}
+	err := c.ShouldBind(req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error Decoding : " + err.Error()})
+		return
+	}
+	if req.PagePileID <= 0 {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Invalid PagePile ID"})
+		return
+	}
+
+	round_service := services.NewRoundService()
+	task, err := round_service.ImportFromPagePile(c, models.IDType(roundId), req.PagePileID)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to import images : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
 type ImportFromCampWizV1Request struct {
 	// The path to the CampWiz V1 database file, must be accessible by the server
 	FromFile string `json:"fromFile" binding:"required"`
@@ -327,7 +481,7 @@ func UpdateRoundDetails(c *gin.Context, sess *cache.Session) {
 	}
 	log.Printf("Request : %+v", req)
 	round_service := services.NewRoundService()
-	round, err := round_service.UpdateRoundDetails(c, models.IDType(roundId), req, q)
+	round, err := round_service.UpdateRoundDetails(c, sess.UserID, models.IDType(roundId), req, q)
 	if err != nil {
 		c.JSON(400, models.ResponseError{Detail: "Failed to update round : " + err.Error()})
 		return
@@ -403,6 +557,37 @@ func DistributeEvaluations(c *gin.Context, sess *cache.Session) {
 	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
 }
 
+// PreviewDistribution godoc
+// @Summary Preview the distribution of evaluations
+// @Description Compute how many assignments each jury would receive, how many submissions would remain under quorum and the conflicts, without assigning anything
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.DistributionPlan]
+// @Router /round/distribute/{roundId}/preview [post]
+// @Param roundId path string true "The round ID"
+// @Param DistributionRequest body services.DistributionRequest true "The distribution request"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func PreviewDistribution(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	distributionReq := &services.DistributionRequest{}
+	if err := c.ShouldBind(distributionReq); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Error Decoding : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	plan, err := round_service.PreviewDistribution(c, sess.UserID, models.IDType(roundId), distributionReq)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to preview the distribution : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.DistributionPlan]{Data: plan})
+}
+
 // GetRound godoc
 // @Summary Get a round
 // @Description Get a round
@@ -434,6 +619,7 @@ func GetRound(c *gin.Context) {
 // @Success 200 {object} models.ResponseList[models.EvaluationResult]
 // @Router /round/{roundId}/results/summary [get]
 // @Param roundId path string true "The round ID"
+// @Param ResultSummaryQuery query models.ResultSummaryQuery false "The normalization of the scores"
 // @Tags Round
 // @Security ApiKeyAuth
 // @Error 400 {object} models.ResponseError
@@ -442,8 +628,13 @@ func GetResultSummary(c *gin.Context, sess *cache.Session) {
 	if roundId == "" {
 		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
 	}
+	q := &models.ResultSummaryQuery{}
+	if err := c.ShouldBindQuery(q); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
 	round_service := services.NewRoundService()
-	results, err := round_service.GetResultSummary(c, models.IDType(roundId))
+	results, err := round_service.GetResultSummary(c, models.IDType(roundId), q)
 	if err != nil {
 		c.JSON(400, models.ResponseError{Detail: "Failed to get round results : " + err.Error()})
 		return
@@ -451,6 +642,37 @@ func GetResultSummary(c *gin.Context, sess *cache.Session) {
 	c.JSON(200, models.ResponseList[models.EvaluationResult]{Data: results})
 }
 
+// GetReliabilityReport godoc
+// @Summary Get the inter-rater reliability of a round
+// @Description Get Krippendorff's alpha and Fleiss' kappa of the round and of every pair of juries, along with the most disputed submissions
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.ReliabilityReport]
+// @Router /round/{roundId}/reliability [get]
+// @Param roundId path string true "The round ID"
+// @Param ReliabilityQuery query models.ReliabilityQuery false "The query of the report"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func GetReliabilityReport(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	q := &models.ReliabilityQuery{}
+	if err := c.ShouldBindQuery(q); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	report, err := round_service.GetReliabilityReport(c, sess, models.IDType(roundId), q)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to get reliability report : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.ReliabilityReport]{Data: report})
+}
+
 // GetResults godoc
 // @Summary Get results of a round
 // @Description Get results of a round
@@ -499,17 +721,27 @@ func GetResults(c *gin.Context, sess *cache.Session) {
 		c.Writer.Header().Set("Content-Type", "text/csv")
 		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment;filename=round-%s-results.csv", roundId))
 		csvWriter := csv.NewWriter(c.Writer)
-		err := csvWriter.Write([]string{"Submission ID", "Name", "Score", "Author", "Evaluation Count", "Media Type"})
+		// The ranking rounds have an additional rank column
+		ranked := len(results) > 0 && results[0].Rank > 0
+		header := []string{"Submission ID", "Name", "Score", "Author", "Evaluation Count", "Media Type"}
+		if ranked {
+			header = append(header, "Rank")
+		}
+		err := csvWriter.Write(header)
 		if err != nil {
 			c.JSON(400, models.ResponseError{Detail: "Failed to write CSV header : " + err.Error()})
 			return
 		}
 		for _, result := range results {
-			err = csvWriter.Write([]string{result.SubmissionID.String(),
+			row := []string{result.SubmissionID.String(),
 				result.Name, fmt.Sprintf("%f", result.Score),
 				result.Author,
 				fmt.Sprintf("%d", result.EvaluationCount),
-				string(result.MediaType)})
+				string(result.MediaType)}
+			if ranked {
+				row = append(row, fmt.Sprintf("%d", result.Rank))
+			}
+			err = csvWriter.Write(row)
 			if err != nil {
 				c.JSON(400, models.ResponseError{Detail: "Failed to write CSV row : " + err.Error()})
 				return
@@ -684,3 +916,177 @@ func Randomize(c *gin.Context, sess *cache.Session) {
 	}
 	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
 }
+
+// SwapAssignments godoc
+// @Summary Swap the assignments of a jury
+// @Description Exchange the unevaluated assignments of a jury with the unevaluated assignments of the other juries of the round
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/{roundId}/swap [post]
+// @Param roundId path string true "The round ID"
+// @Param swapRequest body models.SwapRequest true "The swap request"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func SwapAssignments(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &models.SwapRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	task, err := round_service.SwapAssignments(c, sess.UserID, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to swap assignments : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
+// DeclareConflicts godoc
+// @Summary Declare conflicts of interest
+// @Description Declare the participants or the submissions the current jury would not evaluate in the round
+// @Produce  json
+// @Success 200 {object} models.ResponseList[models.ConflictOfInterest]
+// @Router /round/{roundId}/conflicts [post]
+// @Param roundId path string true "The round ID"
+// @Param conflictRequest body models.ConflictOfInterestRequest true "The conflicts of interest"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func DeclareConflicts(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &models.ConflictOfInterestRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	conflicts, err := round_service.DeclareConflicts(c, sess.UserID, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to declare conflicts : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseList[models.ConflictOfInterest]{Data: conflicts})
+}
+
+// ListConflicts godoc
+// @Summary List my conflicts of interest
+// @Description List the conflicts of interest the current jury has declared in the round
+// @Produce  json
+// @Success 200 {object} models.ResponseList[models.ConflictOfInterest]
+// @Router /round/{roundId}/conflicts [get]
+// @Param roundId path string true "The round ID"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func ListConflicts(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	round_service := services.NewRoundService()
+	conflicts, err := round_service.ListConflicts(c, sess.UserID, models.IDType(roundId))
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to list conflicts : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseList[models.ConflictOfInterest]{Data: conflicts})
+}
+
+// SetJuryCapacities godoc
+// @Summary Set the capacities of the juries
+// @Description Set the relative amount of work each jury can take, used by the capacity weighted distribution
+// @Produce  json
+// @Success 200 {object} models.ResponseList[models.Role]
+// @Router /round/{roundId}/capacities [post]
+// @Param roundId path string true "The round ID"
+// @Param capacityRequest body models.JuryCapacityRequest true "The capacities of the juries"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func SetJuryCapacities(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	req := &models.JuryCapacityRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : " + err.Error()})
+		return
+	}
+	round_service := services.NewRoundService()
+	juries, err := round_service.SetJuryCapacities(c, sess.UserID, models.IDType(roundId), req)
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to set capacities : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseList[models.Role]{Data: juries})
+}
+
+// UndoDistribution godoc
+// @Summary Undo a distribution
+// @Description Unassign all the unevaluated evaluations assigned by a distribution or a swap task
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/{roundId}/undo/{taskId} [post]
+// @Param roundId path string true "The round ID"
+// @Param taskId path string true "The ID of the distribution task"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func UndoDistribution(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	taskId := c.Param("taskId")
+	if taskId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Task ID is required"})
+		return
+	}
+	round_service := services.NewRoundService()
+	task, err := round_service.UndoDistribution(c, sess.UserID, models.IDType(roundId), models.IDType(taskId))
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to undo the distribution : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
+
+// TrimRedundantEvaluations godoc
+// @Summary Trim the redundant evaluations
+// @Description Delete the pending assignments of the submissions that have already reached the quorum
+// @Produce  json
+// @Success 200 {object} models.ResponseSingle[models.Task]
+// @Router /round/{roundId}/trim [post]
+// @Param roundId path string true "The round ID"
+// @Tags Round
+// @Security ApiKeyAuth
+// @Error 400 {object} models.ResponseError
+func TrimRedundantEvaluations(c *gin.Context, sess *cache.Session) {
+	roundId := c.Param("roundId")
+	if roundId == "" {
+		c.JSON(400, models.ResponseError{Detail: "Invalid request : Round ID is required"})
+		return
+	}
+	round_service := services.NewRoundService()
+	task, err := round_service.TrimRedundantEvaluations(c, sess.UserID, models.IDType(roundId))
+	if err != nil {
+		c.JSON(400, models.ResponseError{Detail: "Failed to trim the redundant evaluations : " + err.Error()})
+		return
+	}
+	c.JSON(200, models.ResponseSingle[*models.Task]{Data: task})
+}
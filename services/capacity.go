@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+)
+
+// SetJuryCapacities updates the capacity of the juries of a round, which is used by the capacity weighted distribution.
+// Only the coordinators of the campaign can update the capacities.
+func (r *RoundService) SetJuryCapacities(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *models.JuryCapacityRequest) ([]models.Role, error) {
+	if len(req.Capacities) == 0 {
+		return nil, errors.New("at least one capacity is required")
+	}
+	round_repo := repository.NewRoundRepository()
+	role_repo := repository.NewRoleRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	coordinatorType := models.RoleTypeCoordinator
+	coordinators, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &currentUserID, CampaignID: &round.CampaignID, Type: &coordinatorType})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(coordinators) == 0 {
+		tx.Rollback()
+		return nil, errors.New("only the coordinators can update the capacities of the juries")
+	}
+	usernames := []models.WikimediaUsernameType{}
+	for username, capacity := range req.Capacities {
+		if capacity < 0 {
+			tx.Rollback()
+			return nil, errors.New("capacity cannot be negative: " + username.String())
+		}
+		usernames = append(usernames, username)
+	}
+	userIds, err := repository.NewUserRepository().FetchExistingUsernames(tx, usernames)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	capacities := map[models.IDType]int{}
+	for _, username := range usernames {
+		userID, ok := userIds[username]
+		if !ok {
+			tx.Rollback()
+			return nil, errors.New("user not found: " + username.String())
+		}
+		capacities[userID] = req.Capacities[username]
+	}
+	juryType := models.RoleTypeJury
+	roles, err := role_repo.ListAllRoles(tx, &models.RoleFilter{RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	juries := []models.Role{}
+	for _, jury := range roles {
+		capacity, ok := capacities[jury.UserID]
+		if !ok {
+			continue
+		}
+		jury.Capacity = capacity
+		if res := tx.Model(&models.Role{RoleID: jury.RoleID}).Update("capacity", capacity); res.Error != nil {
+			tx.Rollback()
+			return nil, res.Error
+		}
+		juries = append(juries, jury)
+	}
+	if len(juries) != len(usernames) {
+		tx.Rollback()
+		return nil, errors.New("some of the users are not juries of the round")
+	}
+	tx.Commit()
+	return juries, nil
+}
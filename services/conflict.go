@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+)
+
+// DeclareConflicts records the conflicts of interest of the current user as a jury of the round.
+// The existing unevaluated assignments that conflict are reassigned right away.
+func (r *RoundService) DeclareConflicts(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *models.ConflictOfInterestRequest) ([]models.ConflictOfInterest, error) {
+	if len(req.Participants) == 0 && len(req.SubmissionIDs) == 0 {
+		return nil, errors.New("at least one participant or submission is required")
+	}
+	role_repo := repository.NewRoleRepository()
+	user_repo := repository.NewUserRepository()
+	conflict_repo := repository.NewConflictRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &currentUserID, RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(juries) == 0 {
+		tx.Rollback()
+		return nil, errors.New("user is not a jury of the round")
+	}
+	jury := juries[0]
+	conflicts := []models.ConflictOfInterest{}
+	if len(req.Participants) > 0 {
+		participants, err := user_repo.FetchExistingUsernames(tx, req.Participants)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		for _, username := range req.Participants {
+			participantID, ok := participants[username]
+			if !ok {
+				tx.Rollback()
+				return nil, errors.New("participant not found: " + username.String())
+			}
+			conflicts = append(conflicts, models.ConflictOfInterest{
+				ConflictID:    idgenerator.GenerateID("ci"),
+				RoleID:        jury.RoleID,
+				RoundID:       roundID,
+				Type:          models.ConflictTypeParticipant,
+				ParticipantID: &participantID,
+				Reason:        req.Reason,
+			})
+		}
+	}
+	if len(req.SubmissionIDs) > 0 {
+		q := query.Use(tx)
+		ids := []string{}
+		for _, id := range req.SubmissionIDs {
+			ids = append(ids, id.String())
+		}
+		count, err := q.Submission.Where(q.Submission.SubmissionID.In(ids...), q.Submission.RoundID.Eq(roundID.String())).Count()
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if int(count) != len(ids) {
+			tx.Rollback()
+			return nil, errors.New("some of the submissions do not belong to the round")
+		}
+		for _, submissionID := range req.SubmissionIDs {
+			conflicts = append(conflicts, models.ConflictOfInterest{
+				ConflictID:   idgenerator.GenerateID("ci"),
+				RoleID:       jury.RoleID,
+				RoundID:      roundID,
+				Type:         models.ConflictTypeSubmission,
+				SubmissionID: &submissionID,
+				Reason:       req.Reason,
+			})
+		}
+	}
+	if err := conflict_repo.Create(tx, conflicts); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if _, err := conflict_repo.ReassignConflictingAssignments(tx, roundID, nil); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	return conflicts, nil
+}
+
+// ListConflicts returns the conflicts of interest the current user has declared in the round
+func (r *RoundService) ListConflicts(ctx context.Context, currentUserID models.IDType, roundID models.IDType) ([]models.ConflictOfInterest, error) {
+	role_repo := repository.NewRoleRepository()
+	conflict_repo := repository.NewConflictRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(conn, &models.RoleFilter{UserID: &currentUserID, RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		return nil, err
+	}
+	if len(juries) == 0 {
+		return nil, errors.New("user is not a jury of the round")
+	}
+	conflicts, err := conflict_repo.ListByRoundID(conn, roundID)
+	if err != nil {
+		return nil, err
+	}
+	mine := []models.ConflictOfInterest{}
+	for _, conflict := range conflicts {
+		if conflict.RoleID == juries[0].RoleID {
+			mine = append(mine, conflict)
+		}
+	}
+	return mine, nil
+}
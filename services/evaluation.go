@@ -14,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/net/context"
+	"gorm.io/datatypes"
 )
 
 type EvaluationService struct{}
@@ -29,6 +30,31 @@ type EvaluationRequest struct {
 	SubmissionID models.IDType     `json:"submissionId,omitempty"`
 	Description  *string           `json:"description,omitempty"`
 	Thumbnail    *string           `json:"thumbnail,omitempty"`
+	// CriteriaScores are required instead of the score for the rounds with a rubric
+	CriteriaScores models.CriteriaScores `json:"criteriaScores,omitempty"`
+}
+
+// resolveScore returns the score of an evaluation request.
+// For the rounds with a rubric, the score is the weighted total of the criteria scores.
+func resolveScore(round *models.Round, evaluationRequest *EvaluationRequest) (*models.ScoreType, *datatypes.JSONType[models.CriteriaScores], error) {
+	if round.Rubric == nil || len(round.Rubric.Data()) == 0 {
+		if evaluationRequest.Score == nil {
+			return nil, nil, errors.New("no score is given")
+		}
+		if *evaluationRequest.Score > models.MAXIMUM_EVALUATION_SCORE {
+			return nil, nil, fmt.Errorf("score is greater than %v", models.MAXIMUM_EVALUATION_SCORE)
+		}
+		return evaluationRequest.Score, nil, nil
+	}
+	if len(evaluationRequest.CriteriaScores) == 0 {
+		return nil, nil, errors.New("no criteria scores are given for the rubric")
+	}
+	score, err := round.Rubric.Data().WeightedScore(evaluationRequest.CriteriaScores)
+	if err != nil {
+		return nil, nil, err
+	}
+	criteriaScores := datatypes.NewJSONType(evaluationRequest.CriteriaScores)
+	return &score, &criteriaScores, nil
 }
 
 func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.IDType, evaluationRequests []EvaluationRequest) (result *models.EvaluationListResponseWithCurrentStats, err error) {
@@ -58,11 +84,11 @@ func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.
 	var campaign *models.Campaign
 	var juryRole *models.Role
 	for _, evaluationRequest := range evaluationRequests {
-		if evaluationRequest.Score == nil {
+		if evaluationRequest.Score == nil && len(evaluationRequest.CriteriaScores) == 0 {
 			// tx.Rollback()
 			return nil, fmt.Errorf("no score is given for evaluation %s", evaluationRequest.EvaluationID)
 		}
-		if *evaluationRequest.Score > models.MAXIMUM_EVALUATION_SCORE {
+		if evaluationRequest.Score != nil && *evaluationRequest.Score > models.MAXIMUM_EVALUATION_SCORE {
 			// tx.Rollback()
 			return nil, fmt.Errorf("score is greater than %v", models.MAXIMUM_EVALUATION_SCORE)
 		}
@@ -123,9 +149,10 @@ func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.
 			tx.Rollback()
 			return nil, errors.New("all submissions must be from the same round")
 		}
-		if evaluationRequest.Score == nil {
+		score, criteriaScores, err := resolveScore(currentRound, &evaluationRequest)
+		if err != nil {
 			tx.Rollback()
-			return nil, errors.New("no score is given")
+			return nil, err
 		}
 		// if evaluation.Type == models.EvaluationTypeBinary {
 		// 	log.Println("Binary evaluation")
@@ -137,10 +164,11 @@ func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.
 
 		now := time.Now().UTC()
 		res = tx.Updates(&models.Evaluation{
-			EvaluationID: evaluationRequest.EvaluationID,
-			Score:        evaluationRequest.Score,
-			Comment:      evaluationRequest.Comment,
-			EvaluatedAt:  &now,
+			EvaluationID:   evaluationRequest.EvaluationID,
+			Score:          score,
+			CriteriaScores: criteriaScores,
+			Comment:        evaluationRequest.Comment,
+			EvaluatedAt:    &now,
 		})
 
 		if res.Error != nil {
@@ -167,6 +195,20 @@ func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.
 		return nil, errors.New("no evaluations found")
 	}
 
+	if currentRound.TrimRedundantEvaluations {
+		deleted, err := repository.NewEvaluationRepository().TrimRedundantEvaluations(tx, currentRound.RoundID, currentRound.Quorum, submissionIds)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if deleted > 0 {
+			// The trimmed evaluations are no longer part of the totals of the round
+			if err := query.Use(tx).RoundStatistics.UpdateByRoundID(currentRound.RoundID.String()); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+	}
 	res = tx.Model(&models.Role{}).Where(&models.Role{RoleID: juryRole.RoleID, UserID: juryRole.UserID}).First(&juryRole)
 	if res.Error != nil {
 		tx.Rollback()
@@ -193,6 +235,7 @@ func (e *EvaluationService) BulkEvaluate(ctx *gin.Context, currentUserID models.
 	} else {
 		log.Println("Error creating gRPC client : ", err)
 	}
+	hideAuthors(currentRound, evaluations...)
 	result = &models.EvaluationListResponseWithCurrentStats{
 		ResponseList: models.ResponseList[*models.Evaluation]{
 			Data: evaluations,
@@ -235,12 +278,12 @@ func (e *EvaluationService) PublicBulkEvaluate(ctx context.Context, currentUserI
 	combinedSubmissionIds := []types.SubmissionIDType{}
 	q := query.Use(tx)
 	for _, evaluationRequest := range evaluationRequests {
-		if evaluationRequest.Score == nil {
+		if evaluationRequest.Score == nil && len(evaluationRequest.CriteriaScores) == 0 {
 			tx.Rollback()
 			err = fmt.Errorf("no score is given for evaluation %s", evaluationRequest.EvaluationID)
 			return
 		}
-		if *evaluationRequest.Score > models.MAXIMUM_EVALUATION_SCORE {
+		if evaluationRequest.Score != nil && *evaluationRequest.Score > models.MAXIMUM_EVALUATION_SCORE {
 			tx.Rollback()
 			err = fmt.Errorf("score is greater than %v", models.MAXIMUM_EVALUATION_SCORE)
 			return
@@ -319,17 +362,24 @@ func (e *EvaluationService) PublicBulkEvaluate(ctx context.Context, currentUserI
 				err = errors.New("eReq not found")
 				return
 			}
+			score, criteriaScores, scoreErr := resolveScore(currentRound, &eReq)
+			if scoreErr != nil {
+				tx.Rollback()
+				err = scoreErr
+				return
+			}
 			ev := &models.Evaluation{
-				EvaluationID:  eReq.EvaluationID,
-				SubmissionID:  types.SubmissionIDType(eReq.SubmissionID),
-				JudgeID:       &juryRole.RoleID,
-				RoundID:       currentRound.RoundID,
-				Type:          currentRound.Type,
-				Score:         eReq.Score,
-				Judge:         juryRole,
-				Comment:       eReq.Comment,
-				ParticipantID: submission.ParticipantID,
-				EvaluatedAt:   &now,
+				EvaluationID:   eReq.EvaluationID,
+				SubmissionID:   types.SubmissionIDType(eReq.SubmissionID),
+				JudgeID:        &juryRole.RoleID,
+				RoundID:        currentRound.RoundID,
+				Type:           currentRound.Type,
+				Score:          score,
+				CriteriaScores: criteriaScores,
+				Judge:          juryRole,
+				Comment:        eReq.Comment,
+				ParticipantID:  submission.ParticipantID,
+				EvaluatedAt:    &now,
 			}
 			newEvaluations = append(newEvaluations, ev)
 			combinedSubmissionIds = append(combinedSubmissionIds, submission.SubmissionID)
@@ -404,9 +454,10 @@ func (e *EvaluationService) PublicBulkEvaluate(ctx context.Context, currentUserI
 				err = errors.New("all submissions must be from the same round")
 				return
 			}
-			if evaluationRequest.Score == nil {
+			score, criteriaScores, scoreErr := resolveScore(currentRound, &evaluationRequest)
+			if scoreErr != nil {
 				tx.Rollback()
-				err = errors.New("no score is given")
+				err = scoreErr
 				return
 			}
 			// if evaluation.Type == models.EvaluationTypeBinary {
@@ -417,10 +468,11 @@ func (e *EvaluationService) PublicBulkEvaluate(ctx context.Context, currentUserI
 			// 	log.Println("Score evaluation")
 			// }
 			res := tx.Updates(&models.Evaluation{
-				EvaluationID: evaluationRequest.EvaluationID,
-				Score:        evaluationRequest.Score,
-				Comment:      evaluationRequest.Comment,
-				EvaluatedAt:  &now,
+				EvaluationID:   evaluationRequest.EvaluationID,
+				Score:          score,
+				CriteriaScores: criteriaScores,
+				Comment:        evaluationRequest.Comment,
+				EvaluatedAt:    &now,
 			})
 
 			if res.Error != nil {
@@ -451,6 +503,7 @@ func (e *EvaluationService) PublicBulkEvaluate(ctx context.Context, currentUserI
 		return
 	}
 	tx.Commit()
+	hideAuthors(currentRound, evaluations...)
 	totalAssignmentCount = juryRole.TotalAssigned
 	totalEvaluationCount = juryRole.TotalEvaluated
 	return
@@ -479,13 +532,14 @@ func (e *EvaluationService) Evaluate(ctx context.Context, currentUserID models.I
 		tx.Rollback()
 		return nil, errors.New("evaluation not found")
 	}
-	if evaluation.Type == models.EvaluationTypeBinary && evaluationRequest.Score == nil {
+	hasScore := evaluationRequest.Score != nil || len(evaluationRequest.CriteriaScores) > 0
+	if evaluation.Type == models.EvaluationTypeBinary && !hasScore {
 		tx.Rollback()
 		return nil, errors.New("votePassed is required for binary evaluation")
-	} else if evaluation.Type == models.EvaluationTypeRanking && evaluationRequest.Score == nil {
+	} else if evaluation.Type == models.EvaluationTypeRanking && !hasScore {
 		tx.Rollback()
 		return nil, errors.New("votePosition is required for positional evaluation")
-	} else if evaluation.Type == models.EvaluationTypeScore && evaluationRequest.Score == nil {
+	} else if evaluation.Type == models.EvaluationTypeScore && !hasScore {
 		tx.Rollback()
 		return nil, errors.New("voteScore is required for score evaluation")
 	}
@@ -529,6 +583,15 @@ func (e *EvaluationService) Evaluate(ctx context.Context, currentUserID models.I
 	if evaluationRequest.Comment != "" {
 		evaluation.Comment = evaluationRequest.Comment
 	}
+	score, criteriaScores, err := resolveScore(round, evaluationRequest)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	now := time.Now().UTC()
+	evaluation.Score = score
+	evaluation.CriteriaScores = criteriaScores
+	evaluation.EvaluatedAt = &now
 	res := tx.Updates(&evaluation)
 	if res.Error != nil {
 		tx.Rollback()
@@ -539,14 +602,29 @@ func (e *EvaluationService) Evaluate(ctx context.Context, currentUserID models.I
 		tx.Rollback()
 		return nil, err
 	}
+	if round.TrimRedundantEvaluations {
+		deleted, err := ev_repo.TrimRedundantEvaluations(tx, round.RoundID, round.Quorum, []types.SubmissionIDType{evaluation.SubmissionID})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if deleted > 0 {
+			// The trimmed evaluations are no longer part of the totals of the round
+			if err := query.Use(tx).RoundStatistics.UpdateByRoundID(round.RoundID.String()); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+	}
 	tx.Commit()
+	hideAuthors(round, evaluation)
 	return evaluation, nil
 }
 func (e *EvaluationService) PublicEvaluate(ctx context.Context, currentUserID models.IDType, submissionID types.SubmissionIDType, evaluationRequest *EvaluationRequest) (*models.Evaluation, error) {
 	if evaluationRequest == nil {
 		return nil, errors.New("evaluation request is required")
 	}
-	if evaluationRequest.Score == nil {
+	if evaluationRequest.Score == nil && len(evaluationRequest.CriteriaScores) == 0 {
 		return nil, errors.New("score is required")
 	}
 	if evaluationRequest.EvaluationID != "" {
@@ -596,18 +674,24 @@ func (e *EvaluationService) PublicEvaluate(ctx context.Context, currentUserID mo
 			return nil, res.Error
 		}
 	}
+	score, criteriaScores, err := resolveScore(round, evaluationRequest)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	now := time.Now().UTC()
 	// then create evaluation with the user
 	evaluation := &models.Evaluation{
-		EvaluationID:  idgenerator.GenerateID("e"),
-		SubmissionID:  submission.SubmissionID,
-		JudgeID:       &juryRole.RoleID,
-		Score:         evaluationRequest.Score,
-		Comment:       evaluationRequest.Comment,
-		Type:          models.EvaluationTypeScore,
-		EvaluatedAt:   &now,
-		ParticipantID: submission.ParticipantID,
-		RoundID:       submission.RoundID,
+		EvaluationID:   idgenerator.GenerateID("e"),
+		SubmissionID:   submission.SubmissionID,
+		JudgeID:        &juryRole.RoleID,
+		Score:          score,
+		CriteriaScores: criteriaScores,
+		Comment:        evaluationRequest.Comment,
+		Type:           models.EvaluationTypeScore,
+		EvaluatedAt:    &now,
+		ParticipantID:  submission.ParticipantID,
+		RoundID:        submission.RoundID,
 	}
 	res := tx.Save(evaluation)
 	if res.Error != nil {
@@ -621,6 +705,7 @@ func (e *EvaluationService) PublicEvaluate(ctx context.Context, currentUserID mo
 		return nil, err
 	}
 	tx.Commit()
+	hideAuthors(round, evaluation)
 	return evaluation, nil
 }
 func (e *EvaluationService) GetEvaluationById(ctx context.Context, userId models.IDType, evaluationId models.IDType) (*models.Evaluation, error) {
@@ -632,7 +717,7 @@ func (e *EvaluationService) GetEvaluationById(ctx context.Context, userId models
 	}
 	defer close()
 	tx := conn.Begin()
-	evaluation, err := ev_repo.FindEvaluationByID(tx.Preload("Submission").Preload("Submission.Round"), evaluationId)
+	evaluation, err := ev_repo.FindEvaluationByID(tx.Preload("Submission").Preload("Submission.Round").Preload("Judge"), evaluationId)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
@@ -655,10 +740,46 @@ func (e *EvaluationService) GetEvaluationById(ctx context.Context, userId models
 		tx.Rollback()
 		return nil, errors.New("user can't evaluate his/her own submission")
 	}
+	if submission.Round != nil && submission.Round.SecretBallot {
+		isOwn := evaluation.Judge != nil && evaluation.Judge.UserID == currentUser.UserID
+		if !isOwn && submission.Round.Status != models.RoundStatusCompleted {
+			tx.Rollback()
+			return nil, errors.New("the evaluations of the other juries are hidden until the round is completed")
+		}
+		hideBallot(evaluation, isOwn, submission.Round.Status)
+	}
 	tx.Commit()
+	hideAuthors(submission.Round, evaluation)
 	return evaluation, nil
 }
 
+// hideAuthors redacts the author of the submissions from the evaluations sent to the juries
+// of a round with blind judging. The authors are only revealed in the results.
+func hideAuthors(round *models.Round, evaluations ...*models.Evaluation) {
+	if round == nil || !round.BlindJudging {
+		return
+	}
+	for _, evaluation := range evaluations {
+		evaluation.ParticipantID = ""
+		if evaluation.Submission != nil {
+			evaluation.Submission.HideAuthor()
+		}
+	}
+}
+
+// hideBallot removes from an evaluation of a secret ballot round everything that could reveal
+// which jury gave which score. The jury of the evaluation can still see their own score,
+// but not the average score of the submission until the round is completed.
+func hideBallot(evaluation *models.Evaluation, isOwn bool, status models.RoundStatus) {
+	if !isOwn {
+		evaluation.JudgeID = nil
+		evaluation.Judge = nil
+	}
+	if evaluation.Submission != nil && status != models.RoundStatusCompleted {
+		evaluation.Submission.Score = 0
+	}
+}
+
 // First get the roleID and round ID of the current user
 // if not found, return error
 // check if the role has judge permission
@@ -668,7 +789,7 @@ func (e *EvaluationService) GetEvaluationById(ctx context.Context, userId models
 // - if includeEvaluated is false, evaluated_at is null
 // - if includeEvaluated is nil, no condition
 // - if includeSkipped is true, include skipped submissions
-// - if includeSkipped is false, exclude skipped submissions
+// - if includeSkipped is false or not given, exclude the submissions skipped until now
 func (e *EvaluationService) GetNextEvaluations(ctx context.Context, currenUserID models.IDType, filter *models.EvaluationFilter) (evaluations []*models.Evaluation, totalAssigned int, totalEvaluated int, err error) {
 	ev_repo := repository.NewEvaluationRepository()
 	roleRepo := repository.NewRoleRepository()
@@ -678,7 +799,7 @@ func (e *EvaluationService) GetNextEvaluations(ctx context.Context, currenUserID
 	}
 	defer close()
 	juryType := models.RoleTypeJury
-	roles, err := roleRepo.ListAllRoles(conn, &models.RoleFilter{UserID: &currenUserID, RoundID: &filter.RoundID, Type: &juryType})
+	roles, err := roleRepo.ListAllRoles(conn.Preload("Round"), &models.RoleFilter{UserID: &currenUserID, RoundID: &filter.RoundID, Type: &juryType})
 	if err != nil {
 		return
 	}
@@ -694,6 +815,19 @@ func (e *EvaluationService) GetNextEvaluations(ctx context.Context, currenUserID
 	}
 	juryRoleID := juryRole.RoleID
 	filter.JuryRoleID = juryRoleID
+	if filter.IncludeSkipped == nil {
+		includeSkipped := false
+		filter.IncludeSkipped = &includeSkipped
+	}
 	evaluations, err = ev_repo.ListAllEvaluations(conn, filter)
+	if err != nil {
+		return
+	}
+	if juryRole.Round != nil && juryRole.Round.SecretBallot {
+		for _, evaluation := range evaluations {
+			hideBallot(evaluation, true, juryRole.Round.Status)
+		}
+	}
+	hideAuthors(juryRole.Round, evaluations...)
 	return
 }
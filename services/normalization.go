@@ -0,0 +1,110 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"nokib/campwiz/services/normalization"
+	"slices"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// resolveNormalization returns the normalization strategy requested, falling back to the default of the round
+func resolveNormalization(round *models.Round, requested models.ScoreNormalization) (models.ScoreNormalization, error) {
+	strategy := round.ScoreNormalization
+	if requested != "" {
+		strategy = requested
+	}
+	if strategy == "" {
+		strategy = models.ScoreNormalizationNone
+	}
+	if !strategy.IsValid() {
+		return "", fmt.Errorf("unknown normalization strategy %s", strategy)
+	}
+	return strategy, nil
+}
+
+// normalizedScores computes the score of every submission of the round with the given normalization strategy
+func normalizedScores(conn *gorm.DB, round *models.Round, strategy models.ScoreNormalization) (map[models.IDType]float64, error) {
+	round_repo := repository.NewRoundRepository()
+	scores, err := round_repo.FetchJudgeScores(conn, round.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalization.Normalize(strategy, scores)
+	if err != nil {
+		return nil, err
+	}
+	result := map[models.IDType]float64{}
+	for submissionID, score := range normalized {
+		result[models.IDType(submissionID)] = score
+	}
+	return result, nil
+}
+
+// getNormalizedResults returns the results of a round with the scores normalized per jury
+func (e *RoundService) getNormalizedResults(conn *gorm.DB, round *models.Round, strategy models.ScoreNormalization, qry *models.SubmissionResultQuery) ([]models.SubmissionResult, error) {
+	scores, err := normalizedScores(conn, round, strategy)
+	if err != nil {
+		return nil, err
+	}
+	q := query.Use(conn)
+	Submission := q.Submission
+	submissions := []models.SubmissionResult{}
+	err = Submission.Select(Submission.SubmissionID, Submission.Name, Submission.Score, Submission.Author, Submission.EvaluationCount, Submission.MediaType).
+		Where(Submission.RoundID.Eq(round.RoundID.String())).Scan(&submissions)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.SubmissionResult{}
+	for _, submission := range submissions {
+		if qry != nil && len(qry.Type) > 0 && !slices.Contains(qry.Type, submission.MediaType) {
+			continue
+		}
+		submission.Score = models.ScoreType(roundScore(scores[submission.SubmissionID]))
+		results = append(results, submission)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].EvaluationCount > results[j].EvaluationCount
+	})
+	if qry != nil && qry.Limit > 0 && len(results) > qry.Limit {
+		results = results[:qry.Limit]
+	}
+	return results, nil
+}
+
+// getNormalizedResultSummary groups the submissions of a round by their normalized score
+func (r *RoundService) getNormalizedResultSummary(conn *gorm.DB, round *models.Round, strategy models.ScoreNormalization) ([]models.EvaluationResult, error) {
+	scores, err := normalizedScores(conn, round, strategy)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[float64]int{}
+	for _, score := range scores {
+		counts[roundScore(score)]++
+	}
+	results := []models.EvaluationResult{}
+	for score, count := range counts {
+		results = append(results, models.EvaluationResult{AverageScore: score, SubmissionCount: count})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].AverageScore > results[j].AverageScore
+	})
+	// Same as the summary of the raw scores
+	if len(results) > 100 {
+		results = results[:100]
+	}
+	return results, nil
+}
+
+// roundScore keeps the precision of the stored scores (two decimal places)
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
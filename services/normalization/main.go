@@ -0,0 +1,153 @@
+// Package normalization corrects the bias of the individual juries
+// before the scores of a round are aggregated per submission.
+package normalization
+
+import (
+	"fmt"
+	"math"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"sort"
+)
+
+// The proportion of the scores dropped from each end for the trimmed mean
+const TrimmedProportion = 0.2
+
+// Normalize aggregates the scores per submission using the given strategy.
+// The result is always in the scale of the evaluation scores (0 to MAXIMUM_EVALUATION_SCORE).
+func Normalize(strategy models.ScoreNormalization, scores []models.JudgeScore) (map[types.SubmissionIDType]float64, error) {
+	switch strategy {
+	case "", models.ScoreNormalizationNone:
+		return average(scores), nil
+	case models.ScoreNormalizationZScore:
+		return average(zScores(scores)), nil
+	case models.ScoreNormalizationRankPercentile:
+		return average(rankPercentiles(scores)), nil
+	case models.ScoreNormalizationTrimmedMean:
+		return trimmedMean(scores, TrimmedProportion), nil
+	}
+	return nil, fmt.Errorf("unknown normalization strategy %s", strategy)
+}
+
+func groupByJudge(scores []models.JudgeScore) map[models.IDType][]int {
+	byJudge := map[models.IDType][]int{}
+	for i, score := range scores {
+		byJudge[score.JudgeID] = append(byJudge[score.JudgeID], i)
+	}
+	return byJudge
+}
+func meanAndDeviation(values []float64) (mean float64, deviation float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	for _, v := range values {
+		deviation += (v - mean) * (v - mean)
+	}
+	deviation = math.Sqrt(deviation / float64(len(values)))
+	return mean, deviation
+}
+
+// average is the plain mean of the scores of every submission
+func average(scores []models.JudgeScore) map[types.SubmissionIDType]float64 {
+	total := map[types.SubmissionIDType]float64{}
+	count := map[types.SubmissionIDType]int{}
+	for _, score := range scores {
+		total[score.SubmissionID] += score.Score
+		count[score.SubmissionID]++
+	}
+	for submissionID := range total {
+		total[submissionID] /= float64(count[submissionID])
+	}
+	return total
+}
+
+// zScores replaces every score by its standard score among the scores of the same jury,
+// mapped back to the mean and the deviation of the whole round so that the scale stays familiar.
+// A jury who gave the same score to everything contributes the mean of the round.
+func zScores(scores []models.JudgeScore) []models.JudgeScore {
+	all := make([]float64, len(scores))
+	for i, score := range scores {
+		all[i] = score.Score
+	}
+	roundMean, roundDeviation := meanAndDeviation(all)
+	normalized := make([]models.JudgeScore, len(scores))
+	copy(normalized, scores)
+	for _, indices := range groupByJudge(scores) {
+		values := make([]float64, len(indices))
+		for j, i := range indices {
+			values[j] = scores[i].Score
+		}
+		mean, deviation := meanAndDeviation(values)
+		for _, i := range indices {
+			z := 0.0
+			if deviation > 0 {
+				z = (scores[i].Score - mean) / deviation
+			}
+			normalized[i].Score = clamp(roundMean + z*roundDeviation)
+		}
+	}
+	return normalized
+}
+
+// rankPercentiles replaces every score by its percentile rank among the scores of the same jury.
+// Tied scores share the average of their positions and a single score is placed in the middle.
+func rankPercentiles(scores []models.JudgeScore) []models.JudgeScore {
+	normalized := make([]models.JudgeScore, len(scores))
+	copy(normalized, scores)
+	for _, indices := range groupByJudge(scores) {
+		n := len(indices)
+		if n == 1 {
+			normalized[indices[0]].Score = float64(models.MAXIMUM_EVALUATION_SCORE) / 2
+			continue
+		}
+		sorted := make([]int, n)
+		copy(sorted, indices)
+		sort.SliceStable(sorted, func(a, b int) bool {
+			return scores[sorted[a]].Score < scores[sorted[b]].Score
+		})
+		for start := 0; start < n; {
+			end := start
+			for end+1 < n && scores[sorted[end+1]].Score == scores[sorted[start]].Score {
+				end++
+			}
+			position := float64(start+end) / 2
+			for k := start; k <= end; k++ {
+				normalized[sorted[k]].Score = position / float64(n-1) * float64(models.MAXIMUM_EVALUATION_SCORE)
+			}
+			start = end + 1
+		}
+	}
+	return normalized
+}
+
+// trimmedMean drops the given proportion of the highest and the lowest scores of every submission
+// (at least one from each end when there are three or more scores) before averaging.
+func trimmedMean(scores []models.JudgeScore, proportion float64) map[types.SubmissionIDType]float64 {
+	bySubmission := map[types.SubmissionIDType][]float64{}
+	for _, score := range scores {
+		bySubmission[score.SubmissionID] = append(bySubmission[score.SubmissionID], score.Score)
+	}
+	result := map[types.SubmissionIDType]float64{}
+	for submissionID, values := range bySubmission {
+		sort.Float64s(values)
+		n := len(values)
+		trim := int(float64(n) * proportion)
+		if trim == 0 && n >= 3 {
+			trim = 1
+		}
+		kept := values[trim : n-trim]
+		total := 0.0
+		for _, v := range kept {
+			total += v
+		}
+		result[submissionID] = total / float64(len(kept))
+	}
+	return result
+}
+func clamp(score float64) float64 {
+	return math.Max(0, math.Min(float64(models.MAXIMUM_EVALUATION_SCORE), score))
+}
@@ -0,0 +1,44 @@
+package normalization_test
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/services/normalization"
+	"testing"
+)
+
+func TestNormalizeRemovesJuryBias(t *testing.T) {
+	// The generous jury and the strict jury agree on the order of the submissions
+	scores := []models.JudgeScore{
+		{JudgeID: "j1", SubmissionID: "s1", Score: 90},
+		{JudgeID: "j1", SubmissionID: "s2", Score: 80},
+		{JudgeID: "j2", SubmissionID: "s3", Score: 40},
+		{JudgeID: "j2", SubmissionID: "s4", Score: 20},
+	}
+	for _, strategy := range []models.ScoreNormalization{models.ScoreNormalizationZScore, models.ScoreNormalizationRankPercentile} {
+		result, err := normalization.Normalize(strategy, scores)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", strategy, err)
+		}
+		if result["s1"] != result["s3"] || result["s2"] != result["s4"] {
+			t.Errorf("%s: expected the best and the worst of both juries to be equal, got %v", strategy, result)
+		}
+		if result["s1"] <= result["s2"] {
+			t.Errorf("%s: expected the order within a jury to be kept, got %v", strategy, result)
+		}
+	}
+}
+func TestTrimmedMean(t *testing.T) {
+	scores := []models.JudgeScore{}
+	judges := []models.IDType{"j1", "j2", "j3", "j4", "j5"}
+	for i, score := range []float64{0, 60, 70, 80, 100} {
+		scores = append(scores, models.JudgeScore{JudgeID: judges[i], SubmissionID: "s1", Score: score})
+	}
+	result, err := normalization.Normalize(models.ScoreNormalizationTrimmedMean, scores)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result[types.SubmissionIDType("s1")] != 70 {
+		t.Errorf("expected 70, got %v", result["s1"])
+	}
+}
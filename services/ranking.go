@@ -0,0 +1,358 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+	"nokib/campwiz/services/ranking"
+	"nokib/campwiz/services/round_service"
+	"slices"
+	"time"
+
+	"golang.org/x/net/context"
+	"gorm.io/gorm"
+)
+
+// findRankingJury returns the round and the jury role of the current user for a ranking round
+func findRankingJury(tx *gorm.DB, currentUserID models.IDType, roundID models.IDType) (*models.Round, *models.Role, error) {
+	round_repo := repository.NewRoundRepository()
+	round, err := round_repo.FindByID(tx.Preload("Campaign").Preload("Roles"), roundID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if round.Type != models.EvaluationTypeRanking {
+		return nil, nil, errors.New("round is not a ranking round")
+	}
+	if round.Campaign == nil {
+		return nil, nil, errors.New("campaign not found")
+	}
+	if round.Campaign.Status != models.RoundStatusActive {
+		return nil, nil, errors.New("campaign is not active")
+	}
+	var juryRole *models.Role
+	for _, role := range round.Roles {
+		if role.UserID == currentUserID && role.Type == models.RoleTypeJury {
+			juryRole = &role
+			break
+		}
+	}
+	if juryRole == nil {
+		return nil, nil, errors.New("user is not a jury")
+	}
+	if juryRole.DeletedAt != nil {
+		return nil, nil, errors.New("user is not allowed to evaluate")
+	}
+	return round, juryRole, nil
+}
+
+// SubmitRanking stores the ordered list of submissions of a jury in a ranking round.
+// The submissions are ranked from the best (first) to the worst (last) and
+// the submissions assigned to the jury but missing from the list are marked as not evaluated.
+func (e *EvaluationService) SubmitRanking(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *models.RankingRequest) ([]*models.Evaluation, error) {
+	if len(req.SubmissionIDs) == 0 {
+		return nil, errors.New("no submission is given")
+	}
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, juryRole, err := findRankingJury(tx, currentUserID, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	evaluations, err := Evaluation.Where(Evaluation.RoundID.Eq(round.RoundID.String()), Evaluation.JudgeID.Eq(juryRole.RoleID.String())).Find()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	evaluationBySubmission := map[types.SubmissionIDType]*models.Evaluation{}
+	for _, evaluation := range evaluations {
+		evaluationBySubmission[evaluation.SubmissionID] = evaluation
+	}
+	ranked := map[types.SubmissionIDType]bool{}
+	for _, submissionID := range req.SubmissionIDs {
+		if _, ok := evaluationBySubmission[submissionID]; !ok {
+			tx.Rollback()
+			return nil, fmt.Errorf("submission %s is not assigned to the user", submissionID)
+		}
+		if ranked[submissionID] {
+			tx.Rollback()
+			return nil, fmt.Errorf("submission %s is ranked more than once", submissionID)
+		}
+		ranked[submissionID] = true
+	}
+	now := time.Now().UTC()
+	total := len(req.SubmissionIDs)
+	submissionIds := []types.SubmissionIDType{}
+	result := []*models.Evaluation{}
+	for i, submissionID := range req.SubmissionIDs {
+		evaluation := evaluationBySubmission[submissionID]
+		rank := uint(i + 1)
+		// The score keeps the existing statistics meaningful, the best one gets the maximum score
+		score := models.MAXIMUM_EVALUATION_SCORE
+		if total > 1 {
+			score = models.MAXIMUM_EVALUATION_SCORE * models.ScoreType(total-1-i) / models.ScoreType(total-1)
+		}
+		res := tx.Model(&models.Evaluation{EvaluationID: evaluation.EvaluationID}).Updates(map[string]any{
+			"rank":         rank,
+			"score":        score,
+			"evaluated_at": &now,
+		})
+		if res.Error != nil {
+			tx.Rollback()
+			return nil, res.Error
+		}
+		evaluation.Rank = &rank
+		evaluation.Score = &score
+		evaluation.EvaluatedAt = &now
+		result = append(result, evaluation)
+		submissionIds = append(submissionIds, submissionID)
+	}
+	for _, evaluation := range evaluations {
+		if ranked[evaluation.SubmissionID] || evaluation.Rank == nil {
+			continue
+		}
+		// It was ranked in an earlier submission of the list but not anymore
+		res := tx.Model(&models.Evaluation{EvaluationID: evaluation.EvaluationID}).Updates(map[string]any{
+			"rank":         nil,
+			"score":        nil,
+			"evaluated_at": nil,
+		})
+		if res.Error != nil {
+			tx.Rollback()
+			return nil, res.Error
+		}
+		submissionIds = append(submissionIds, evaluation.SubmissionID)
+	}
+	if res := tx.Commit(); res.Error != nil {
+		return nil, res.Error
+	}
+	triggerEvaluationScoreCount(submissionIds)
+	return result, nil
+}
+
+// SubmitPairwisePreferences stores the pairwise preferences of a jury in a ranking round.
+// A new preference between the same two submissions replaces the earlier one.
+func (e *EvaluationService) SubmitPairwisePreferences(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *models.PairwisePreferenceRequest) ([]models.RankingPreference, error) {
+	if len(req.Preferences) == 0 {
+		return nil, errors.New("no preference is given")
+	}
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, juryRole, err := findRankingJury(tx, currentUserID, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	evaluations, err := Evaluation.Where(Evaluation.RoundID.Eq(round.RoundID.String()), Evaluation.JudgeID.Eq(juryRole.RoleID.String())).Find()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	assigned := map[types.SubmissionIDType]*models.Evaluation{}
+	for _, evaluation := range evaluations {
+		assigned[evaluation.SubmissionID] = evaluation
+	}
+	RankingPreference := q.RankingPreference
+	preferences := []models.RankingPreference{}
+	for _, preference := range req.Preferences {
+		if preference.WinnerID == preference.LoserID {
+			tx.Rollback()
+			return nil, errors.New("a submission can't be preferred over itself")
+		}
+		if assigned[preference.WinnerID] == nil || assigned[preference.LoserID] == nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("submissions %s and %s must be assigned to the user", preference.WinnerID, preference.LoserID)
+		}
+		pair := []string{preference.WinnerID.String(), preference.LoserID.String()}
+		_, err := RankingPreference.Where(RankingPreference.RoundID.Eq(round.RoundID.String()),
+			RankingPreference.JudgeID.Eq(juryRole.RoleID.String()),
+			RankingPreference.WinnerID.In(pair...), RankingPreference.LoserID.In(pair...)).Delete()
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		preferences = append(preferences, models.RankingPreference{
+			PreferenceID: idgenerator.GenerateID("p"),
+			RoundID:      round.RoundID,
+			JudgeID:      juryRole.RoleID,
+			WinnerID:     preference.WinnerID,
+			LoserID:      preference.LoserID,
+		})
+	}
+	if res := tx.Create(&preferences); res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+	// The score of every compared submission is the percentage of the contests it has won
+	allPreferences, err := RankingPreference.Where(RankingPreference.RoundID.Eq(round.RoundID.String()),
+		RankingPreference.JudgeID.Eq(juryRole.RoleID.String())).Find()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	wins := map[types.SubmissionIDType]int{}
+	contests := map[types.SubmissionIDType]int{}
+	for _, preference := range allPreferences {
+		wins[preference.WinnerID]++
+		contests[preference.WinnerID]++
+		contests[preference.LoserID]++
+	}
+	now := time.Now().UTC()
+	submissionIds := []types.SubmissionIDType{}
+	for submissionID, count := range contests {
+		evaluation := assigned[submissionID]
+		if evaluation == nil {
+			// An earlier preference of a submission that has been reassigned to another jury since
+			continue
+		}
+		if evaluation.Rank != nil {
+			// An ordered list was submitted for this submission, which takes precedence
+			continue
+		}
+		score := models.MAXIMUM_EVALUATION_SCORE * models.ScoreType(wins[submissionID]) / models.ScoreType(count)
+		res := tx.Model(&models.Evaluation{EvaluationID: evaluation.EvaluationID}).Updates(map[string]any{
+			"score":        score,
+			"evaluated_at": &now,
+		})
+		if res.Error != nil {
+			tx.Rollback()
+			return nil, res.Error
+		}
+		submissionIds = append(submissionIds, submissionID)
+	}
+	if res := tx.Commit(); res.Error != nil {
+		return nil, res.Error
+	}
+	triggerEvaluationScoreCount(submissionIds)
+	return preferences, nil
+}
+
+// getRankingResults aggregates the ordered lists and the pairwise preferences of all the juries of a ranking round
+func (e *RoundService) getRankingResults(conn *gorm.DB, round *models.Round, qry *models.SubmissionResultQuery) ([]models.SubmissionResult, error) {
+	method := models.RankingMethodBorda
+	if qry != nil && qry.Method != "" {
+		method = qry.Method
+	}
+	if method != models.RankingMethodBorda && method != models.RankingMethodSchulze {
+		return nil, fmt.Errorf("unknown ranking method %s", method)
+	}
+	q := query.Use(conn)
+	Submission := q.Submission
+	submissions := []models.SubmissionResult{}
+	err := Submission.Select(Submission.SubmissionID, Submission.Name, Submission.Score, Submission.Author, Submission.EvaluationCount, Submission.MediaType).
+		Where(Submission.RoundID.Eq(round.RoundID.String())).
+		Order(Submission.SubmissionID).Scan(&submissions)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []models.IDType{}
+	submissionMap := map[models.IDType]models.SubmissionResult{}
+	for _, submission := range submissions {
+		candidates = append(candidates, submission.SubmissionID)
+		submissionMap[submission.SubmissionID] = submission
+	}
+	matrix := ranking.NewPairwiseMatrix(candidates)
+	Evaluation := q.Evaluation
+	rankedEvaluations, err := Evaluation.Select(Evaluation.JudgeID, Evaluation.SubmissionID, Evaluation.Rank).
+		Where(Evaluation.RoundID.Eq(round.RoundID.String()), Evaluation.Rank.IsNotNull()).
+		Order(Evaluation.JudgeID, Evaluation.Rank).Find()
+	if err != nil {
+		return nil, err
+	}
+	ballots := map[models.IDType]ranking.Ballot{}
+	ballotMembers := map[models.IDType]map[types.SubmissionIDType]bool{}
+	for _, evaluation := range rankedEvaluations {
+		if evaluation.JudgeID == nil {
+			continue
+		}
+		judgeID := *evaluation.JudgeID
+		if ballotMembers[judgeID] == nil {
+			ballotMembers[judgeID] = map[types.SubmissionIDType]bool{}
+		}
+		ballots[judgeID] = append(ballots[judgeID], models.IDType(evaluation.SubmissionID))
+		ballotMembers[judgeID][evaluation.SubmissionID] = true
+	}
+	for _, ballot := range ballots {
+		matrix.AddBallot(ballot)
+	}
+	RankingPreference := q.RankingPreference
+	preferences, err := RankingPreference.Where(RankingPreference.RoundID.Eq(round.RoundID.String())).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, preference := range preferences {
+		members := ballotMembers[preference.JudgeID]
+		if members[preference.WinnerID] && members[preference.LoserID] {
+			// Already counted from the ordered list of the same jury
+			continue
+		}
+		matrix.AddPreference(models.IDType(preference.WinnerID), models.IDType(preference.LoserID))
+	}
+	results := []models.SubmissionResult{}
+	for _, standing := range ranking.Aggregate(method, matrix) {
+		result, ok := submissionMap[standing.SubmissionID]
+		if !ok {
+			continue
+		}
+		if qry != nil {
+			if len(qry.Type) > 0 && !slices.Contains(qry.Type, result.MediaType) {
+				continue
+			}
+			// The tokens filter the submissions like the results of the other rounds, after the aggregation so that the ranks stay the same
+			if qry.ContinueToken != "" && result.SubmissionID.String() <= qry.ContinueToken {
+				continue
+			}
+			if qry.PreviousToken != "" && result.SubmissionID.String() >= qry.PreviousToken {
+				continue
+			}
+		}
+		result.Score = models.ScoreType(standing.Points)
+		result.Rank = standing.Rank
+		results = append(results, result)
+	}
+	if qry != nil && qry.Limit > 0 && len(results) > qry.Limit {
+		results = results[:qry.Limit]
+	}
+	return results, nil
+}
+
+// triggerEvaluationScoreCount asks the task manager to update the statistics of the given submissions
+func triggerEvaluationScoreCount(submissionIds []types.SubmissionIDType) {
+	if len(submissionIds) == 0 {
+		return
+	}
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		log.Println("Error creating gRPC client : ", err)
+		return
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	statisticsupdater := models.NewStatisticsUpdaterClient(grpcClient)
+	ids := []string{}
+	for _, submission := range submissionIds {
+		ids = append(ids, submission.String())
+	}
+	_, err = statisticsupdater.TriggerEvaluationScoreCount(context.Background(), &models.UpdateStatisticsRequest{
+		SubmissionIds: ids,
+	})
+	if err != nil {
+		log.Println("Error updating statistics : ", err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package ranking aggregates the ordered lists and the pairwise preferences
+// of the juries of a ranking round into a single standing.
+package ranking
+
+import (
+	"nokib/campwiz/models"
+	"sort"
+)
+
+// Ballot is an ordered list of submissions, the best one first
+type Ballot []models.IDType
+
+// Standing is the final position of a submission after aggregation
+type Standing struct {
+	SubmissionID models.IDType
+	// Points is the borda count for borda and the number of submissions beaten for schulze
+	Points float64
+	// Rank starts from 1, tied submissions share the same rank
+	Rank int
+}
+
+// PairwiseMatrix counts, for every ordered pair (a, b), how many times a was preferred over b
+type PairwiseMatrix struct {
+	candidates []models.IDType
+	index      map[models.IDType]int
+	d          [][]int
+}
+
+func NewPairwiseMatrix(candidates []models.IDType) *PairwiseMatrix {
+	m := &PairwiseMatrix{
+		candidates: []models.IDType{},
+		index:      map[models.IDType]int{},
+	}
+	for _, c := range candidates {
+		m.addCandidate(c)
+	}
+	return m
+}
+func (m *PairwiseMatrix) addCandidate(c models.IDType) int {
+	if i, ok := m.index[c]; ok {
+		return i
+	}
+	i := len(m.candidates)
+	m.index[c] = i
+	m.candidates = append(m.candidates, c)
+	for j := range m.d {
+		m.d[j] = append(m.d[j], 0)
+	}
+	m.d = append(m.d, make([]int, len(m.candidates)))
+	return i
+}
+
+// AddBallot records that every submission of the ballot is preferred over all the submissions after it
+func (m *PairwiseMatrix) AddBallot(ballot Ballot) {
+	for i := range ballot {
+		for j := i + 1; j < len(ballot); j++ {
+			m.AddPreference(ballot[i], ballot[j])
+		}
+	}
+}
+
+// AddPreference records that the winner was preferred over the loser once
+func (m *PairwiseMatrix) AddPreference(winner, loser models.IDType) {
+	if winner == loser {
+		return
+	}
+	w := m.addCandidate(winner)
+	l := m.addCandidate(loser)
+	m.d[w][l]++
+}
+
+// Borda returns the borda count of every candidate. For partial ballots a submission
+// receives one point for every submission ranked below it, which is
+// the same as the number of pairwise contests it has won.
+func Borda(m *PairwiseMatrix) []Standing {
+	standings := make([]Standing, len(m.candidates))
+	for i, c := range m.candidates {
+		points := 0
+		for j := range m.candidates {
+			points += m.d[i][j]
+		}
+		standings[i] = Standing{SubmissionID: c, Points: float64(points)}
+	}
+	assignRanks(standings)
+	return standings
+}
+
+// Schulze returns the standings according to the schulze method.
+// Submissions are ordered by the number of other submissions they beat
+// through the strongest paths, ties are broken by the borda count.
+func Schulze(m *PairwiseMatrix) []Standing {
+	n := len(m.candidates)
+	p := make([][]int, n)
+	for i := range p {
+		p[i] = make([]int, n)
+		for j := range p[i] {
+			if i != j && m.d[i][j] > m.d[j][i] {
+				p[i][j] = m.d[i][j]
+			}
+		}
+	}
+	for i := range n {
+		for j := range n {
+			if i == j {
+				continue
+			}
+			for k := range n {
+				if i == k || j == k {
+					continue
+				}
+				p[j][k] = max(p[j][k], min(p[j][i], p[i][k]))
+			}
+		}
+	}
+	borda := Borda(m)
+	bordaPoints := map[models.IDType]float64{}
+	for _, s := range borda {
+		bordaPoints[s.SubmissionID] = s.Points
+	}
+	standings := make([]Standing, n)
+	for i, c := range m.candidates {
+		wins := 0
+		for j := range n {
+			if i != j && p[i][j] > p[j][i] {
+				wins++
+			}
+		}
+		standings[i] = Standing{SubmissionID: c, Points: float64(wins)}
+	}
+	sort.SliceStable(standings, func(a, b int) bool {
+		if standings[a].Points != standings[b].Points {
+			return standings[a].Points > standings[b].Points
+		}
+		if bordaPoints[standings[a].SubmissionID] != bordaPoints[standings[b].SubmissionID] {
+			return bordaPoints[standings[a].SubmissionID] > bordaPoints[standings[b].SubmissionID]
+		}
+		return standings[a].SubmissionID < standings[b].SubmissionID
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && standings[i].Points == standings[i-1].Points &&
+			bordaPoints[standings[i].SubmissionID] == bordaPoints[standings[i-1].SubmissionID] {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+	return standings
+}
+
+// Aggregate computes the standings using the given method, defaulting to borda
+func Aggregate(method models.RankingMethod, m *PairwiseMatrix) []Standing {
+	if method == models.RankingMethodSchulze {
+		return Schulze(m)
+	}
+	return Borda(m)
+}
+
+// assignRanks sorts the standings by their points and assigns the ranks
+func assignRanks(standings []Standing) {
+	sort.SliceStable(standings, func(a, b int) bool {
+		if standings[a].Points != standings[b].Points {
+			return standings[a].Points > standings[b].Points
+		}
+		return standings[a].SubmissionID < standings[b].SubmissionID
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && standings[i].Points == standings[i-1].Points {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+}
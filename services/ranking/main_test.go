@@ -0,0 +1,53 @@
+package ranking_test
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/services/ranking"
+	"testing"
+)
+
+func TestBordaPartialBallots(t *testing.T) {
+	m := ranking.NewPairwiseMatrix([]models.IDType{"sA", "sB", "sC", "sD"})
+	m.AddBallot(ranking.Ballot{"sA", "sB", "sC"})
+	m.AddBallot(ranking.Ballot{"sB", "sA"})
+	m.AddPreference("sC", "sD")
+	standings := ranking.Borda(m)
+	expected := []ranking.Standing{
+		{SubmissionID: "sA", Points: 2, Rank: 1},
+		{SubmissionID: "sB", Points: 2, Rank: 1},
+		{SubmissionID: "sC", Points: 1, Rank: 3},
+		{SubmissionID: "sD", Points: 0, Rank: 4},
+	}
+	for i, s := range standings {
+		if s != expected[i] {
+			t.Errorf("expected %v at %d, got %v", expected[i], i, s)
+		}
+	}
+}
+func TestSchulze(t *testing.T) {
+	// The example from the original paper by Markus Schulze with 45 voters
+	m := ranking.NewPairwiseMatrix(nil)
+	add := func(times int, ballot ranking.Ballot) {
+		for range times {
+			m.AddBallot(ballot)
+		}
+	}
+	add(5, ranking.Ballot{"A", "C", "B", "E", "D"})
+	add(5, ranking.Ballot{"A", "D", "E", "C", "B"})
+	add(8, ranking.Ballot{"B", "E", "D", "A", "C"})
+	add(3, ranking.Ballot{"C", "A", "B", "E", "D"})
+	add(7, ranking.Ballot{"C", "A", "E", "B", "D"})
+	add(2, ranking.Ballot{"C", "B", "A", "D", "E"})
+	add(7, ranking.Ballot{"D", "C", "E", "B", "A"})
+	add(8, ranking.Ballot{"E", "B", "A", "D", "C"})
+	standings := ranking.Schulze(m)
+	expected := []models.IDType{"E", "A", "C", "B", "D"}
+	for i, s := range standings {
+		if s.SubmissionID != expected[i] {
+			t.Errorf("expected %s at %d, got %s", expected[i], i, s.SubmissionID)
+		}
+		if s.Rank != i+1 {
+			t.Errorf("expected rank %d for %s, got %d", i+1, s.SubmissionID, s.Rank)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+
+	"gorm.io/gorm"
+)
+
+// createRebalanceTask records the rebalance of a round after its juries have changed. The task must be started
+// with runRebalance once the change is committed, so that the request does not wait for it.
+// A round that has never been distributed is left as is, the first distribution would take care of it.
+func createRebalanceTask(tx *gorm.DB, currentUserID models.IDType, round *models.Round) (*models.Task, error) {
+	if round.LatestDistributionTaskID == nil {
+		return nil, nil
+	}
+	task := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeRebalanceAssignments,
+		Status:               models.TaskStatusPending,
+		AssociatedRoundID:    &round.RoundID,
+		AssociatedUserID:     &currentUserID,
+		CreatedByID:          currentUserID,
+		AssociatedCampaignID: &round.CampaignID,
+	}
+	return repository.NewTaskRepository().Create(tx, task)
+}
+
+// runRebalance moves the unevaluated assignments of the removed or overloaded juries to the least loaded ones
+// in the background and records the outcome in the task
+func runRebalance(task *models.Task) {
+	task_repo := repository.NewTaskRepository()
+	evaluation_repo := repository.NewEvaluationRepository()
+	conn, close, err := repository.GetDB(context.Background())
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	defer close()
+	tx := conn.Begin()
+	moved, unassigned, err := evaluation_repo.Rebalance(tx, *task.AssociatedRoundID, task.TaskID)
+	if err != nil {
+		tx.Rollback()
+		log.Println("Error: ", err)
+		task.Status = models.TaskStatusFailed
+	} else {
+		tx.Commit()
+		log.Printf("Rebalanced round %s: %d moved, %d left unassigned", *task.AssociatedRoundID, moved, unassigned)
+		task.Status = models.TaskStatusSuccess
+		task.SuccessCount = moved
+		task.FailedCount = unassigned
+	}
+	if _, err := task_repo.Update(conn, &models.Task{
+		TaskID:       task.TaskID,
+		Status:       task.Status,
+		SuccessCount: task.SuccessCount,
+		FailedCount:  task.FailedCount,
+	}); err != nil {
+		log.Println("Error: ", err)
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"nokib/campwiz/consts"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"nokib/campwiz/repository/cache"
+	"nokib/campwiz/services/reliability"
+)
+
+// GetReliabilityReport measures how much the juries of a round agree with each other.
+// As the report reveals the scores of the others, it requires PermissionSeeOthersEvaluationResult
+// either globally or through a role in the campaign.
+func (r *RoundService) GetReliabilityReport(ctx context.Context, sess *cache.Session, roundID models.IDType, qry *models.ReliabilityQuery) (*models.ReliabilityReport, error) {
+	round_repo := repository.NewRoundRepository()
+	role_repo := repository.NewRoleRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	round, err := round_repo.FindByID(conn, roundID)
+	if err != nil {
+		return nil, err
+	}
+	if !sess.Permission.HasPermission(consts.PermissionSeeOthersEvaluationResult) {
+		roles, err := role_repo.ListAllRoles(conn, &models.RoleFilter{UserID: &sess.UserID, CampaignID: &round.CampaignID})
+		if err != nil {
+			return nil, err
+		}
+		allowed := false
+		for _, role := range roles {
+			if role.Permission.HasPermission(consts.PermissionSeeOthersEvaluationResult) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, errors.New("user does not have permission to see the evaluation results of others")
+		}
+	}
+	scores, err := round_repo.FetchJudgeScores(conn, roundID)
+	if err != nil {
+		return nil, err
+	}
+	report := &models.ReliabilityReport{
+		RoundID:          roundID,
+		TotalEvaluations: len(scores),
+		Juries:           []models.JuryReliability{},
+		Pairs:            []models.JuryPairAgreement{},
+		MostDisputed:     []models.DisputedSubmission{},
+	}
+	if alpha, ok := reliability.KrippendorffAlpha(scores); ok {
+		report.KrippendorffAlpha = &alpha
+	}
+	if kappa, ok := reliability.FleissKappa(scores); ok {
+		report.FleissKappa = &kappa
+	}
+	q := query.Use(conn)
+	statistics, err := q.JuryStatistics.GetJuryStatistics(roundID.String())
+	if err != nil {
+		return nil, err
+	}
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(conn.Preload("User"), &models.RoleFilter{RoundID: &roundID, Type: &juryType})
+	if err != nil {
+		return nil, err
+	}
+	usernames := map[models.IDType]models.WikimediaUsernameType{}
+	for _, jury := range juries {
+		// In a secret ballot round the juries are kept anonymous in the report
+		if jury.User != nil && !round.SecretBallot {
+			usernames[jury.RoleID] = jury.User.Username
+		}
+	}
+	// In a secret ballot round, the role IDs are replaced by labels which are shuffled on every report,
+	// and who scored how is not revealed until the round is completed
+	labels := map[models.IDType]models.IDType{}
+	if round.SecretBallot {
+		for i, j := range rand.Perm(len(statistics)) {
+			labels[statistics[i].JudgeID] = models.IDType(fmt.Sprintf("jury-%d", j+1))
+		}
+	}
+	anonymize := func(judgeID models.IDType) models.IDType {
+		if label, ok := labels[judgeID]; ok {
+			return label
+		}
+		return judgeID
+	}
+	revealScores := !round.SecretBallot || round.Status == models.RoundStatusCompleted
+	totalScore := map[models.IDType]float64{}
+	totalScored := map[models.IDType]int{}
+	for _, score := range scores {
+		totalScore[score.JudgeID] += score.Score
+		totalScored[score.JudgeID]++
+	}
+	for _, statistic := range statistics {
+		jury := models.JuryReliability{
+			JuryStatistics: statistic,
+			Username:       usernames[statistic.JudgeID],
+		}
+		jury.JudgeID = anonymize(statistic.JudgeID)
+		if revealScores && totalScored[statistic.JudgeID] > 0 {
+			average := totalScore[statistic.JudgeID] / float64(totalScored[statistic.JudgeID])
+			jury.AverageScore = &average
+		}
+		report.Juries = append(report.Juries, jury)
+	}
+	for _, pair := range reliability.Pairs(scores) {
+		agreement := models.JuryPairAgreement{
+			CommonSubmissions:      pair.CommonSubmissions,
+			MeanAbsoluteDifference: pair.MeanAbsoluteDifference,
+		}
+		if revealScores {
+			agreement.JudgeA = anonymize(pair.JudgeA)
+			agreement.JudgeB = anonymize(pair.JudgeB)
+		}
+		if pair.AlphaDefined {
+			agreement.KrippendorffAlpha = &pair.Alpha
+		}
+		if pair.KappaDefined {
+			agreement.FleissKappa = &pair.Kappa
+		}
+		report.Pairs = append(report.Pairs, agreement)
+	}
+	limit := 20
+	if qry != nil && qry.DisputedLimit > 0 {
+		limit = qry.DisputedLimit
+	}
+	disputes := reliability.MostDisputed(scores, limit)
+	if len(disputes) > 0 {
+		submissionIds := []string{}
+		for _, dispute := range disputes {
+			submissionIds = append(submissionIds, dispute.SubmissionID.String())
+		}
+		submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.Name).
+			Where(q.Submission.SubmissionID.In(submissionIds...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		names := map[types.SubmissionIDType]string{}
+		for _, submission := range submissions {
+			names[submission.SubmissionID] = submission.Name
+		}
+		for _, dispute := range disputes {
+			report.MostDisputed = append(report.MostDisputed, models.DisputedSubmission{
+				SubmissionID:    dispute.SubmissionID,
+				Name:            names[dispute.SubmissionID],
+				AverageScore:    dispute.Mean,
+				Variance:        dispute.Variance,
+				EvaluationCount: dispute.Count,
+			})
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,191 @@
+// Package reliability measures how much the juries of a round agree with each other.
+package reliability
+
+import (
+	"math"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"sort"
+)
+
+// KrippendorffAlpha computes Krippendorff's alpha for interval data.
+// Every submission is a unit and only the submissions scored by at least two juries are pairable.
+// It returns false when the alpha is undefined (no pairable values or no variation at all).
+func KrippendorffAlpha(scores []models.JudgeScore) (float64, bool) {
+	units := groupBySubmission(scores)
+	n := 0
+	sum := 0.0
+	sumOfSquares := 0.0
+	observed := 0.0
+	for _, values := range units {
+		m := len(values)
+		if m < 2 {
+			continue
+		}
+		unitSum := 0.0
+		unitSumOfSquares := 0.0
+		for _, v := range values {
+			unitSum += v
+			unitSumOfSquares += v * v
+		}
+		// sum over all the ordered pairs (i != j) of (vi - vj)^2
+		observed += (2*float64(m)*unitSumOfSquares - 2*unitSum*unitSum) / float64(m-1)
+		n += m
+		sum += unitSum
+		sumOfSquares += unitSumOfSquares
+	}
+	if n < 2 {
+		return 0, false
+	}
+	expected := (2*float64(n)*sumOfSquares - 2*sum*sum) / float64(n-1)
+	if expected == 0 {
+		return 0, false
+	}
+	return 1 - observed/expected, true
+}
+
+// FleissKappa computes Fleiss' kappa treating every distinct score as a category.
+// The number of juries may differ between the submissions, the ones with a single score are ignored.
+// It returns false when the kappa is undefined.
+func FleissKappa(scores []models.JudgeScore) (float64, bool) {
+	units := groupBySubmission(scores)
+	categoryTotals := map[float64]int{}
+	totalRatings := 0
+	agreement := 0.0
+	subjects := 0
+	for _, values := range units {
+		m := len(values)
+		if m < 2 {
+			continue
+		}
+		counts := map[float64]int{}
+		for _, v := range values {
+			counts[v]++
+			categoryTotals[v]++
+		}
+		pairs := 0
+		for _, c := range counts {
+			pairs += c * (c - 1)
+		}
+		agreement += float64(pairs) / float64(m*(m-1))
+		totalRatings += m
+		subjects++
+	}
+	if subjects == 0 {
+		return 0, false
+	}
+	observed := agreement / float64(subjects)
+	expected := 0.0
+	for _, c := range categoryTotals {
+		p := float64(c) / float64(totalRatings)
+		expected += p * p
+	}
+	if expected == 1 {
+		return 0, false
+	}
+	return (observed - expected) / (1 - expected), true
+}
+
+// PairAgreement is the agreement between two juries on the submissions both of them have scored
+type PairAgreement struct {
+	JudgeA                 models.IDType
+	JudgeB                 models.IDType
+	CommonSubmissions      int
+	MeanAbsoluteDifference float64
+	Alpha                  float64
+	AlphaDefined           bool
+	Kappa                  float64
+	KappaDefined           bool
+}
+
+// Pairs computes the agreement of every pair of juries having at least one submission in common
+func Pairs(scores []models.JudgeScore) []PairAgreement {
+	byJudge := map[models.IDType]map[types.SubmissionIDType]float64{}
+	for _, score := range scores {
+		if byJudge[score.JudgeID] == nil {
+			byJudge[score.JudgeID] = map[types.SubmissionIDType]float64{}
+		}
+		byJudge[score.JudgeID][score.SubmissionID] = score.Score
+	}
+	judges := []models.IDType{}
+	for judgeID := range byJudge {
+		judges = append(judges, judgeID)
+	}
+	sort.Slice(judges, func(i, j int) bool { return judges[i] < judges[j] })
+	pairs := []PairAgreement{}
+	for i, a := range judges {
+		for _, b := range judges[i+1:] {
+			common := []models.JudgeScore{}
+			difference := 0.0
+			for submissionID, scoreA := range byJudge[a] {
+				scoreB, ok := byJudge[b][submissionID]
+				if !ok {
+					continue
+				}
+				common = append(common,
+					models.JudgeScore{JudgeID: a, SubmissionID: submissionID, Score: scoreA},
+					models.JudgeScore{JudgeID: b, SubmissionID: submissionID, Score: scoreB})
+				difference += math.Abs(scoreA - scoreB)
+			}
+			if len(common) == 0 {
+				continue
+			}
+			pair := PairAgreement{
+				JudgeA:                 a,
+				JudgeB:                 b,
+				CommonSubmissions:      len(common) / 2,
+				MeanAbsoluteDifference: difference / float64(len(common)/2),
+			}
+			pair.Alpha, pair.AlphaDefined = KrippendorffAlpha(common)
+			pair.Kappa, pair.KappaDefined = FleissKappa(common)
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+// Dispute is the spread of the scores of a submission
+type Dispute struct {
+	SubmissionID types.SubmissionIDType
+	Mean         float64
+	Variance     float64
+	Count        int
+}
+
+// MostDisputed returns the submissions with the highest (population) variance of their scores
+func MostDisputed(scores []models.JudgeScore, limit int) []Dispute {
+	disputes := []Dispute{}
+	for submissionID, values := range groupBySubmission(scores) {
+		if len(values) < 2 {
+			continue
+		}
+		mean := 0.0
+		for _, v := range values {
+			mean += v
+		}
+		mean /= float64(len(values))
+		variance := 0.0
+		for _, v := range values {
+			variance += (v - mean) * (v - mean)
+		}
+		variance /= float64(len(values))
+		disputes = append(disputes, Dispute{SubmissionID: submissionID, Mean: mean, Variance: variance, Count: len(values)})
+	}
+	sort.Slice(disputes, func(i, j int) bool {
+		if disputes[i].Variance != disputes[j].Variance {
+			return disputes[i].Variance > disputes[j].Variance
+		}
+		return disputes[i].SubmissionID < disputes[j].SubmissionID
+	})
+	if limit > 0 && len(disputes) > limit {
+		disputes = disputes[:limit]
+	}
+	return disputes
+}
+func groupBySubmission(scores []models.JudgeScore) map[types.SubmissionIDType][]float64 {
+	units := map[types.SubmissionIDType][]float64{}
+	for _, score := range scores {
+		units[score.SubmissionID] = append(units[score.SubmissionID], score.Score)
+	}
+	return units
+}
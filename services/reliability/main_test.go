@@ -0,0 +1,67 @@
+package reliability_test
+
+import (
+	"fmt"
+	"math"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/services/reliability"
+	"testing"
+)
+
+func TestFleissKappa(t *testing.T) {
+	// The example from Fleiss (1971): 10 subjects, 14 raters and 5 categories
+	table := [][]int{
+		{0, 0, 0, 0, 14},
+		{0, 2, 6, 4, 2},
+		{0, 0, 3, 5, 6},
+		{0, 3, 9, 2, 0},
+		{2, 2, 8, 1, 1},
+		{7, 7, 0, 0, 0},
+		{3, 2, 6, 3, 0},
+		{2, 5, 3, 2, 2},
+		{6, 5, 2, 1, 0},
+		{0, 2, 2, 3, 7},
+	}
+	scores := []models.JudgeScore{}
+	for i, row := range table {
+		judge := 0
+		for category, count := range row {
+			for range count {
+				scores = append(scores, models.JudgeScore{
+					JudgeID:      models.IDType(fmt.Sprintf("j%d", judge)),
+					SubmissionID: types.SubmissionIDType(fmt.Sprintf("s%d", i)),
+					Score:        float64(category * 25),
+				})
+				judge++
+			}
+		}
+	}
+	kappa, ok := reliability.FleissKappa(scores)
+	if !ok {
+		t.Fatal("expected kappa to be defined")
+	}
+	if math.Abs(kappa-0.210) > 0.001 {
+		t.Errorf("expected kappa 0.210, got %f", kappa)
+	}
+}
+func TestKrippendorffAlphaPerfectAgreement(t *testing.T) {
+	scores := []models.JudgeScore{
+		{JudgeID: "j1", SubmissionID: "s1", Score: 20},
+		{JudgeID: "j2", SubmissionID: "s1", Score: 20},
+		{JudgeID: "j1", SubmissionID: "s2", Score: 80},
+		{JudgeID: "j2", SubmissionID: "s2", Score: 80},
+		{JudgeID: "j3", SubmissionID: "s2", Score: 80},
+	}
+	alpha, ok := reliability.KrippendorffAlpha(scores)
+	if !ok || alpha != 1 {
+		t.Errorf("expected alpha 1, got %f (defined: %v)", alpha, ok)
+	}
+	pairs := reliability.Pairs(scores)
+	if len(pairs) != 3 {
+		t.Fatalf("expected 3 pairs, got %d", len(pairs))
+	}
+	if pairs[0].CommonSubmissions != 2 || pairs[0].MeanAbsoluteDifference != 0 {
+		t.Errorf("unexpected agreement between j1 and j2 : %+v", pairs[0])
+	}
+}
@@ -15,6 +15,9 @@ import (
 	idgenerator "nokib/campwiz/services/idGenerator"
 	"nokib/campwiz/services/round_service"
 	"os"
+	"path/filepath"
+	"reflect"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/datatypes"
@@ -32,11 +35,41 @@ type RoundRequest struct {
 type DistributionRequest struct {
 	TargetJuriesUsername []models.WikimediaUsernameType `json:"juries"`
 	SourceJuriesUsername []models.WikimediaUsernameType `json:"sourceJuries"`
+	// Strategy overrides the distribution strategy of the round
+	Strategy string `json:"strategy"`
+	// Parameters are specific to the strategy, e.g. `defaultCapacity` of the capacity-weighted one
+	Parameters map[string]string `json:"parameters"`
 }
 type ImportFromCommonsPayload struct {
 	// Categories from which images will be fetched
 	Categories []string `json:"categories" binding:"required"`
+	// The number of the levels of the subcategories to import from, 0 (default) imports only the given categories
+	Depth int `json:"depth"`
+	// The files in these categories are skipped and their subcategories are not traversed
+	ExcludedCategories []string `json:"excludedCategories"`
 }
+type ImportFromUploadersPayload struct {
+	// The usernames of the uploaders whose files will be imported
+	Usernames []models.WikimediaUsernameType `json:"usernames" binding:"required"`
+	// The start of the upload window, defaults to the start date of the campaign
+	StartDate *time.Time `json:"startDate"`
+	// The end of the upload window, defaults to the end date of the campaign
+	EndDate *time.Time `json:"endDate"`
+}
+type ImportFromWikipediaPayload struct {
+	// The language code of the Wikipedia (e.g. en, bn)
+	Language string `json:"language" binding:"required"`
+	// The titles of the articles, if empty the articles created within the date window are imported
+	Titles []string `json:"titles"`
+	// The start of the date window, defaults to the start date of the campaign
+	StartDate *time.Time `json:"startDate"`
+	// The end of the date window, defaults to the end date of the campaign
+	EndDate *time.Time `json:"endDate"`
+}
+
+// MaxCommonsCategoryDepth is the deepest level of the subcategories that can be imported from
+const MaxCommonsCategoryDepth = 10
+
 type ImportFromPreviousRoundPayload struct {
 	// RoundID from which images will be fetched
 	RoundID models.IDType `json:"roundId" binding:"required"`
@@ -54,6 +87,13 @@ type ImportFromCSVRequest struct {
 	FileNameColumn string `form:"fileNameColumn"`
 }
 
+type ImportFromPetScanRequest struct {
+	// The JSON result of the PetScan query (format=json)
+	File *multipart.FileHeader `form:"file"`
+	// The PetScan ID of a result already exported to the petscan directory of the tool, used if no file is uploaded
+	PSID int64 `form:"psid"`
+}
+
 type Jury struct {
 	ID            uint64 `json:"id" gorm:"primaryKey"`
 	totalAssigned int
@@ -82,6 +122,14 @@ func (s *RoundService) CreateRound(ctx context.Context, request *RoundRequest) (
 		return nil, err
 	}
 	defer close()
+	if request.Rubric != nil {
+		if err := request.Rubric.Data().Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if request.ScoreNormalization != "" && !request.ScoreNormalization.IsValid() {
+		return nil, fmt.Errorf("unknown normalization strategy %s", request.ScoreNormalization)
+	}
 	tx := conn.Begin()
 	campaign, err := campaign_repo.FindByID(tx.Preload("LatestRound"), request.CampaignID)
 	if err != nil {
@@ -170,7 +218,10 @@ func (s *RoundService) ListAllRounds(ctx context.Context, filter *models.RoundFi
 	return rounds, nil
 }
 
-func (b *RoundService) ImportFromCommons(ctx context.Context, roundId models.IDType, categories []string) (*models.Task, error) {
+func (b *RoundService) ImportFromCommons(ctx context.Context, roundId models.IDType, req *ImportFromCommonsPayload) (*models.Task, error) {
+	if req.Depth < 0 || req.Depth > MaxCommonsCategoryDepth {
+		return nil, fmt.Errorf("depth must be between 0 and %d", MaxCommonsCategoryDepth)
+	}
 	round_repo := repository.NewRoundRepository()
 	task_repo := repository.NewTaskRepository()
 	conn, close, err := repository.GetDB(ctx)
@@ -220,9 +271,11 @@ func (b *RoundService) ImportFromCommons(ctx context.Context, roundId models.IDT
 
 	importer := models.NewImporterClient(grpcConn)
 	importResponse, err := importer.ImportFromCommonsCategory(ctx, &models.ImportFromCommonsCategoryRequest{
-		CommonsCategory: categories,
-		RoundId:         round.RoundID.String(),
-		TaskId:          task.TaskID.String(),
+		CommonsCategory:    req.Categories,
+		Depth:              int32(req.Depth),
+		ExcludedCategories: req.ExcludedCategories,
+		RoundId:            round.RoundID.String(),
+		TaskId:             task.TaskID.String(),
 	})
 	if err != nil {
 		return nil, err
@@ -445,6 +498,299 @@ func (b *RoundService) ImportFromFountain(ctx context.Context, roundId models.ID
 	})
 	return task, err
 }
+
+// ImportFromPagePile imports the files of a PagePile into the round, the titles of the pile are resolved to the page IDs on the Commons replica
+func (b *RoundService) ImportFromPagePile(ctx context.Context, roundId models.IDType, pagePileID int64) (*models.Task, error) {
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx.Preload("Campaign"), roundId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if round == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("round not found")
+	} else if round.Campaign == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("campaign not found")
+	}
+
+	taskReq := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeImportFromPagePile,
+		Status:               models.TaskStatusPending,
+		AssociatedRoundID:    &roundId,
+		AssociatedUserID:     &round.CreatedByID,
+		CreatedByID:          round.CreatedByID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         0,
+		FailedCount:          0,
+		FailedIds:            &datatypes.JSONType[map[string]string]{},
+		RemainingCount:       0,
+	}
+	task, err := task_repo.Create(tx, taskReq)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	importClient := models.NewImporterClient(grpcClient)
+	_, err = importClient.ImportFromPagePile(cache.WithGRPCContext(ctx), &models.ImportFromPagePileRequest{
+		PagepileId: pagePileID,
+		RoundId:    round.RoundID.String(),
+		TaskId:     task.TaskID.String(),
+	})
+	return task, err
+}
+
+// ImportFromPetScan imports the files listed in a PetScan result into the round.
+// The result is either uploaded or resolved by its PSID from the locally stored exports.
+func (b *RoundService) ImportFromPetScan(ctx context.Context, roundId models.IDType, req *ImportFromPetScanRequest) (*models.Task, error) {
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx.Preload("Campaign"), roundId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if round == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("round not found")
+	} else if round.Campaign == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("campaign not found")
+	}
+	tempDir := os.Getenv("TOOL_DATA_DIR")
+	path := ""
+	temporary := false
+	if req.File != nil {
+		tempFile, err := os.CreateTemp(tempDir, "import-*.json")
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		defer tempFile.Close() //nolint:errcheck
+		src, err := req.File.Open()
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		defer src.Close() //nolint:errcheck
+		if _, err := io.Copy(tempFile, src); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		path = tempFile.Name()
+		temporary = true
+	} else if req.PSID > 0 {
+		path = filepath.Join(tempDir, "petscan", fmt.Sprintf("%d.json", req.PSID))
+		if _, err := os.Stat(path); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("petscan result %d not found", req.PSID)
+		}
+	} else {
+		tx.Rollback()
+		return nil, errors.New("either a file or a PSID is required")
+	}
+
+	taskReq := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeImportFromPetScan,
+		Status:               models.TaskStatusPending,
+		AssociatedRoundID:    &roundId,
+		AssociatedUserID:     &round.CreatedByID,
+		CreatedByID:          round.CreatedByID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         0,
+		FailedCount:          0,
+		FailedIds:            &datatypes.JSONType[map[string]string]{},
+		RemainingCount:       0,
+	}
+	task, err := task_repo.Create(tx, taskReq)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	importClient := models.NewImporterClient(grpcClient)
+	_, err = importClient.ImportFromPetScan(cache.WithGRPCContext(ctx), &models.ImportFromPetScanRequest{
+		Path:      path,
+		Temporary: temporary,
+		RoundId:   round.RoundID.String(),
+		TaskId:    task.TaskID.String(),
+	})
+	return task, err
+}
+
+// ImportFromUploaders imports every file uploaded by the given users within the date window into the round
+func (b *RoundService) ImportFromUploaders(ctx context.Context, roundId models.IDType, req *ImportFromUploadersPayload) (*models.Task, error) {
+	if len(req.Usernames) == 0 {
+		return nil, errors.New("at least one username is required")
+	}
+	if req.StartDate != nil && req.EndDate != nil && !req.StartDate.Before(*req.EndDate) {
+		return nil, errors.New("start date must be before the end date")
+	}
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx.Preload("Campaign"), roundId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if round == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("round not found")
+	}
+	campaign := round.Campaign
+	if campaign == nil || campaign.ArchivedAt != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("campaign not found or archived")
+	}
+	taskReq := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeImportFromUploaders,
+		Status:               models.TaskStatusPending,
+		AssociatedRoundID:    &roundId,
+		AssociatedUserID:     &round.CreatedByID,
+		CreatedByID:          round.CreatedByID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         0,
+		FailedCount:          0,
+		FailedIds:            &datatypes.JSONType[map[string]string]{},
+		RemainingCount:       0,
+	}
+	task, err := task_repo.Create(tx, taskReq)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	usernames := []string{}
+	for _, username := range req.Usernames {
+		usernames = append(usernames, string(username))
+	}
+	importReq := &models.ImportFromUploadersRequest{
+		Usernames: usernames,
+		RoundId:   round.RoundID.String(),
+		TaskId:    task.TaskID.String(),
+	}
+	if req.StartDate != nil {
+		importReq.StartTimestamp = req.StartDate.Unix()
+	}
+	if req.EndDate != nil {
+		importReq.EndTimestamp = req.EndDate.Unix()
+	}
+	importClient := models.NewImporterClient(grpcClient)
+	_, err = importClient.ImportFromUploaders(cache.WithGRPCContext(ctx), importReq)
+	return task, err
+}
+
+// ImportFromWikipedia imports the articles of a Wikipedia into a round of a wikipedia campaign,
+// along with the bytes and the words added to them within the date window
+func (b *RoundService) ImportFromWikipedia(ctx context.Context, roundId models.IDType, req *ImportFromWikipediaPayload) (*models.Task, error) {
+	if _, err := repository.NewWikipediaRepository(req.Language, nil); err != nil {
+		return nil, err
+	}
+	if req.StartDate != nil && req.EndDate != nil && !req.StartDate.Before(*req.EndDate) {
+		return nil, errors.New("start date must be before the end date")
+	}
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx.Preload("Campaign"), roundId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if round == nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("round not found")
+	}
+	campaign := round.Campaign
+	if campaign == nil || campaign.ArchivedAt != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("campaign not found or archived")
+	}
+	if campaign.CampaignType != models.CampaignTypeWikipedia {
+		tx.Rollback()
+		return nil, errors.New("articles can only be imported into wikipedia campaigns")
+	}
+	taskReq := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeImportFromWikipedia,
+		Status:               models.TaskStatusPending,
+		AssociatedRoundID:    &roundId,
+		AssociatedUserID:     &round.CreatedByID,
+		CreatedByID:          round.CreatedByID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         0,
+		FailedCount:          0,
+		FailedIds:            &datatypes.JSONType[map[string]string]{},
+		RemainingCount:       0,
+	}
+	task, err := task_repo.Create(tx, taskReq)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	importReq := &models.ImportFromWikipediaRequest{
+		Language: req.Language,
+		Titles:   req.Titles,
+		RoundId:  round.RoundID.String(),
+		TaskId:   task.TaskID.String(),
+	}
+	if req.StartDate != nil {
+		importReq.StartTimestamp = req.StartDate.Unix()
+	}
+	if req.EndDate != nil {
+		importReq.EndTimestamp = req.EndDate.Unix()
+	}
+	importClient := models.NewImporterClient(grpcClient)
+	_, err = importClient.ImportFromWikipedia(cache.WithGRPCContext(ctx), importReq)
+	return task, err
+}
+
 func (b *RoundService) ImportFromCampWizV1(ctx context.Context, dbFileName string, fromCampaignId int32, toRoundId models.IDType) (*models.Task, error) {
 	round_repo := repository.NewRoundRepository()
 	task_repo := repository.NewTaskRepository()
@@ -510,7 +856,7 @@ func (b *RoundService) GetById(ctx context.Context, roundId models.IDType) (*mod
 	return round_repo.FindByID(conn, roundId)
 }
 
-func (r *RoundService) UpdateRoundDetails(ctx context.Context, roundID models.IDType, req *RoundRequest, qry *models.SingleCampaignFilter) (*models.Round, error) {
+func (r *RoundService) UpdateRoundDetails(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *RoundRequest, qry *models.SingleCampaignFilter) (*models.Round, error) {
 	round_repo := repository.NewRoundRepository()
 	role_service := NewRoleService()
 	conn, close, err := repository.GetDB(ctx)
@@ -532,6 +878,18 @@ func (r *RoundService) UpdateRoundDetails(ctx context.Context, roundID models.ID
 		tx.Rollback()
 		return nil, errors.New("round is not paused")
 	}
+	if req.Rubric != nil {
+		if err := req.Rubric.Data().Validate(); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if req.ScoreNormalization != "" && !req.ScoreNormalization.IsValid() {
+		tx.Rollback()
+		return nil, fmt.Errorf("unknown normalization strategy %s", req.ScoreNormalization)
+	}
+	// A missing rubric in the request keeps the existing one
+	rubricChanged := req.Rubric != nil && !reflect.DeepEqual(round.Rubric, req.Rubric)
 	previousRound := round.DependsOnRound
 	if previousRound != nil {
 		log.Println("Previous round found with ID: ", previousRound.RoundID)
@@ -565,6 +923,13 @@ func (r *RoundService) UpdateRoundDetails(ctx context.Context, roundID models.ID
 		tx.Rollback()
 		return nil, err
 	}
+	if rubricChanged {
+		if err := r.rescoreRubricEvaluations(tx, round); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	var rebalanceTask *models.Task
 	if !req.IsPublicJury {
 		juryType := models.RoleTypeJury
 		filter := &models.RoleFilter{
@@ -615,6 +980,13 @@ func (r *RoundService) UpdateRoundDetails(ctx context.Context, roundID models.ID
 				return nil, err
 			}
 		}
+		if len(addedRoles) > 0 || len(removedRoles) > 0 {
+			rebalanceTask, err = createRebalanceTask(tx, currentUserID, round)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
 	}
 	if qry != nil {
 		stmt := tx
@@ -637,6 +1009,9 @@ func (r *RoundService) UpdateRoundDetails(ctx context.Context, roundID models.ID
 		}
 	}
 	tx.Commit()
+	if rebalanceTask != nil {
+		go runRebalance(rebalanceTask)
+	}
 	return round, nil
 }
 func (r *RoundService) DistributeEvaluations(ctx context.Context, currentUserID models.IDType, roundId models.IDType, distributionReq *DistributionRequest) (*models.Task, error) {
@@ -686,6 +1061,60 @@ func (r *RoundService) DistributeEvaluations(ctx context.Context, currentUserID
 	defer grpcClient.Close() //nolint:errcheck
 	distributorClient := models.NewDistributorClient(grpcClient)
 	log.Printf("Distribution request: %+v", distributionReq)
+	_, err = distributorClient.Distribute(context.Background(), distributionReq.rpcRequest(round, task.TaskID, false))
+	if err != nil {
+		// The task would never be picked up, e.g. because of an unknown strategy
+		task.Status = models.TaskStatusFailed
+		if _, updateErr := task_repo.Update(conn, task); updateErr != nil {
+			log.Println("Error: ", updateErr)
+		}
+	}
+	return task, err
+}
+
+// PreviewDistribution computes the outcome of a distribution without assigning anything.
+// Like the distribution itself, it is only available to the coordinators and not while the round is active.
+func (r *RoundService) PreviewDistribution(ctx context.Context, currentUserID models.IDType, roundId models.IDType, distributionReq *DistributionRequest) (*models.DistributionPlan, error) {
+	round_repo := repository.NewRoundRepository()
+	role_repo := repository.NewRoleRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	round, err := round_repo.FindByID(conn, roundId)
+	if err != nil {
+		return nil, err
+	} else if round == nil {
+		return nil, fmt.Errorf("round not found")
+	}
+	if round.Status == models.RoundStatusActive {
+		return nil, errors.New("please pause the round before distributing evaluations")
+	}
+	coordinatorType := models.RoleTypeCoordinator
+	coordinators, err := role_repo.ListAllRoles(conn, &models.RoleFilter{UserID: &currentUserID, CampaignID: &round.CampaignID, Type: &coordinatorType})
+	if err != nil {
+		return nil, err
+	}
+	if len(coordinators) == 0 {
+		return nil, errors.New("only the coordinators can preview a distribution")
+	}
+	grpcClient, err := round_service.NewGrpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer grpcClient.Close() //nolint:errcheck
+	distributorClient := models.NewDistributorClient(grpcClient)
+	res, err := distributorClient.Distribute(ctx, distributionReq.rpcRequest(round, "", true))
+	if err != nil {
+		return nil, err
+	}
+	return res.Plan, nil
+}
+
+// rpcRequest converts the request to the task manager request.
+// The strategy of the request takes precedence over the strategy of the round, the task manager falls back to its default.
+func (distributionReq *DistributionRequest) rpcRequest(round *models.Round, taskID models.IDType, dryRun bool) *models.DistributeRequest {
 	juryUsername := make([]string, len(distributionReq.TargetJuriesUsername))
 	for i, username := range distributionReq.TargetJuriesUsername {
 		juryUsername[i] = username.String()
@@ -694,21 +1123,42 @@ func (r *RoundService) DistributeEvaluations(ctx context.Context, currentUserID
 	for i, username := range distributionReq.SourceJuriesUsername {
 		sourceJuryUsername[i] = username.String()
 	}
-	_, err = distributorClient.DistributeWithRoundRobin(context.Background(), &models.DistributeWithRoundRobinRequest{
+	strategy := distributionReq.Strategy
+	if strategy == "" {
+		strategy = round.DistributionStrategy
+	}
+	return &models.DistributeRequest{
 		RoundId:             round.RoundID.String(),
-		TaskId:              task.TaskID.String(),
+		TaskId:              taskID.String(),
+		Strategy:            strategy,
 		TargetJuryUsernames: juryUsername,
 		SourceJuryUsernames: sourceJuryUsername,
-	})
-	return task, err
+		Parameters:          distributionReq.Parameters,
+		DryRun:              dryRun,
+	}
 }
-func (r *RoundService) GetResultSummary(ctx context.Context, roundID models.IDType) (results []models.EvaluationResult, err error) {
+func (r *RoundService) GetResultSummary(ctx context.Context, roundID models.IDType, qry *models.ResultSummaryQuery) (results []models.EvaluationResult, err error) {
 	round_repo := repository.NewRoundRepository()
 	conn, close, err := repository.GetDB(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer close()
+	round, err := round_repo.FindByID(conn, roundID)
+	if err != nil {
+		return nil, err
+	}
+	requested := models.ScoreNormalization("")
+	if qry != nil {
+		requested = qry.Normalization
+	}
+	strategy, err := resolveNormalization(round, requested)
+	if err != nil {
+		return nil, err
+	}
+	if strategy != models.ScoreNormalizationNone {
+		return r.getNormalizedResultSummary(conn, round, strategy)
+	}
 	results, err = round_repo.GetResultSummary(conn, roundID)
 	if err != nil {
 		return nil, err
@@ -746,6 +1196,11 @@ func (e *RoundService) GetNextUnevaluatedSubmissionForPublicJury(ctx context.Con
 	if err != nil {
 		return
 	}
+	if round.BlindJudging {
+		for _, submission := range submissions {
+			submission.HideAuthor()
+		}
+	}
 	return
 }
 func (e *RoundService) UpdateStatus(ctx context.Context, currentUserID models.IDType, roundID models.IDType, status models.RoundStatus) (*models.Round, error) {
@@ -895,6 +1350,20 @@ func (e *RoundService) GetResults(ctx context.Context, currentUserID models.IDTy
 	if len(role) == 0 {
 		return nil, errors.New("user is not a coordinator")
 	}
+	if round.Type == models.EvaluationTypeRanking {
+		return e.getRankingResults(conn, round, q)
+	}
+	requested := models.ScoreNormalization("")
+	if q != nil {
+		requested = q.Normalization
+	}
+	strategy, err := resolveNormalization(round, requested)
+	if err != nil {
+		return nil, err
+	}
+	if strategy != models.ScoreNormalizationNone {
+		return e.getNormalizedResults(conn, round, strategy, q)
+	}
 	return round_repo.GetResults(conn, roundID, q)
 }
 func (e *RoundService) DeleteRound(ctx context.Context, sess *cache.Session, roundID models.IDType) error {
@@ -991,7 +1460,16 @@ func (e *RoundService) AddMyselfAsJury(ctx context.Context, currentUserID models
 		tx.Rollback()
 		return nil, err
 	}
+	// The new jury takes over the excess of the others
+	rebalanceTask, err := createRebalanceTask(tx, currentUserID, round)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	tx.Commit()
+	if rebalanceTask != nil {
+		go runRebalance(rebalanceTask)
+	}
 	return role, nil
 }
 
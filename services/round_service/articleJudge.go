@@ -0,0 +1,108 @@
+package round_service
+
+import (
+	"fmt"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RejectReasonMissingArticleInformation = "missing-article-information"
+	RejectReasonCreationNotAllowed        = "creation-not-allowed"
+	RejectReasonExpansionNotAllowed       = "expansion-not-allowed"
+	RejectReasonBelowMinimumTotalBytes    = "below-minimum-total-bytes"
+	RejectReasonBelowMinimumTotalWords    = "below-minimum-total-words"
+	RejectReasonBelowMinimumAddedBytes    = "below-minimum-added-bytes"
+	RejectReasonBelowMinimumAddedWords    = "below-minimum-added-words"
+	RejectReasonTooManySubmissions        = "above-maximum-submission-of-same-article"
+)
+
+// articleRejectReason checks an article of a wikipedia campaign against the article restrictions of the round
+// The type and the upload date window are checked by RejectReason beforehand. It would consider
+//   - Whether creations and expansions are allowed (if none of them is allowed, the round does not restrict it)
+//   - Minimum total and added bytes and words
+//   - Maximum number of submissions of the same article
+func (j *TechnicalJudgeService) articleRejectReason(submission models.MediaResult) string {
+	article := submission.Article
+	if article == nil {
+		return RejectReasonMissingArticleInformation
+	}
+	restrictions := j.ArticleRestrictions
+	restrictsKind := restrictions.ArticleAllowCreations || restrictions.ArticleAllowExpansions
+	if restrictsKind && article.IsNewArticle && !restrictions.ArticleAllowCreations {
+		return RejectReasonCreationNotAllowed
+	}
+	if restrictsKind && !article.IsNewArticle && !restrictions.ArticleAllowExpansions {
+		return RejectReasonExpansionNotAllowed
+	}
+	if article.TotalBytes < uint64(restrictions.ArticleMinimumTotalBytes) {
+		return RejectReasonBelowMinimumTotalBytes
+	}
+	if article.TotalWords < uint64(restrictions.ArticleMinimumTotalWords) {
+		return RejectReasonBelowMinimumTotalWords
+	}
+	if article.AddedBytes < uint64(restrictions.ArticleMinimumAddedBytes) {
+		return RejectReasonBelowMinimumAddedBytes
+	}
+	if article.AddedWords < uint64(restrictions.ArticleMinimumAddedWords) {
+		return RejectReasonBelowMinimumAddedWords
+	}
+	// A submission copied from a previous round of the campaign has already been counted
+	if restrictions.MaximumSubmissionOfSameArticle > 0 && submission.SubmissionID == "" {
+		key := articleKey(article.Language, submission.PageID, submission.Name)
+		if j.articleCount[key] >= restrictions.MaximumSubmissionOfSameArticle {
+			return RejectReasonTooManySubmissions
+		}
+		j.articleCount[key]++
+	}
+	return ""
+}
+
+// articleKey identifies an article by its page ID, or by its title if the page ID is unknown
+func articleKey(language string, pageID uint64, name string) string {
+	if pageID == 0 {
+		return fmt.Sprintf("%s:%s", language, name)
+	}
+	return fmt.Sprintf("%s:%d", language, pageID)
+}
+
+// LoadArticleCounts counts the existing submissions of every article in the campaign, so that
+// MaximumSubmissionOfSameArticle also holds across the imports. As the submissions are copied from a round
+// to the next one, an article counts as many times as it appears in the round where it appears the most.
+func (j *TechnicalJudgeService) LoadArticleCounts(tx *gorm.DB, round *models.Round) error {
+	if j.CampaignType != models.CampaignTypeWikipedia || j.ArticleRestrictions.MaximumSubmissionOfSameArticle == 0 {
+		return nil
+	}
+	type articleRoundCount struct {
+		Language string
+		PageID   uint64
+		Name     string
+		RoundID  models.IDType
+		Count    int
+	}
+	rows := []articleRoundCount{}
+	Submission := query.Use(tx).Submission
+	err := Submission.Select(Submission.Language, Submission.PageID, Submission.Name, Submission.RoundID, Submission.SubmissionID.Count().As("Count")).
+		Where(Submission.CampaignID.Eq(round.CampaignID.String())).
+		Group(Submission.Language, Submission.PageID, Submission.Name, Submission.RoundID).
+		Scan(&rows)
+	if err != nil {
+		return err
+	}
+	perRound := map[string]map[models.IDType]int{}
+	for _, row := range rows {
+		key := articleKey(row.Language, row.PageID, row.Name)
+		if perRound[key] == nil {
+			perRound[key] = map[models.IDType]int{}
+		}
+		perRound[key][row.RoundID] += row.Count
+	}
+	for key, counts := range perRound {
+		for _, count := range counts {
+			j.articleCount[key] = max(j.articleCount[key], count)
+		}
+	}
+	return nil
+}
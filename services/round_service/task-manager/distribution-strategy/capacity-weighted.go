@@ -0,0 +1,318 @@
+package distributionstrategy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// CapacityWeightedDistributionStrategy splits the assignments of a round among the juries proportionally to their capacity.
+// The already evaluated assignments count towards the share of a jury, the unevaluated ones are redistributed.
+type CapacityWeightedDistributionStrategy struct {
+	*RoundRobinDistributionStrategy
+	// DefaultCapacity is the capacity of the juries which have none, taken from the `defaultCapacity` parameter.
+	// If it is zero, they get the average capacity of the others.
+	DefaultCapacity int
+}
+
+func newCapacityWeightedStrategy(req *models.DistributeRequest) (*CapacityWeightedDistributionStrategy, error) {
+	defaultCapacity, err := intParameter(req, "defaultCapacity")
+	if err != nil {
+		return nil, err
+	}
+	if defaultCapacity < 0 {
+		return nil, fmt.Errorf("defaultCapacity must not be negative: %d", defaultCapacity)
+	}
+	return &CapacityWeightedDistributionStrategy{
+		RoundRobinDistributionStrategy: newRoundRobinStrategy(req),
+		DefaultCapacity:                defaultCapacity,
+	}, nil
+}
+
+func (strategy *CapacityWeightedDistributionStrategy) Distribute(ctx context.Context) {
+	log.Println("Assigning juries weighted by their capacity")
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer close()
+	task, round, finish, err := startDistribution(conn, strategy.TaskId, strategy.RoundId)
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	defer finish()
+	juries, err := fetchDistributionJuries(conn, round, strategy.SourceJuries)
+	if err != nil {
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	if len(juries) == 0 {
+		log.Println("No juries found")
+		task.Status = models.TaskStatusFailed
+		return
+	}
+	submission_repo := repository.NewSubmissionRepository()
+	submissions, err := submission_repo.ListAllSubmissions(conn.Where("assignment_count < ?", round.Quorum), &models.SubmissionListFilter{
+		RoundID:    round.RoundID,
+		CampaignID: round.CampaignID,
+	})
+	if err != nil {
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	created, err := strategy.createMissingEvaluations(conn, round.Type, round, submissions)
+	if err != nil {
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	log.Printf("Created %d missing evaluations", created)
+	tx := conn.Begin()
+	task.SuccessCount, task.FailedCount, err = strategy.assign(tx, round, juries)
+	if err != nil {
+		tx.Rollback()
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	if err = preventSelfEvaluation(tx, round.RoundID, &strategy.TaskId); err != nil {
+		tx.Rollback()
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	if err = strategy.triggerStatisticsUpdateByRoundID(tx, round); err != nil {
+		tx.Rollback()
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	tx.Commit()
+	task.Status = models.TaskStatusSuccess
+}
+
+// fetchDistributionJuries returns the requested juries of the round, or all of them if none was requested
+func fetchDistributionJuries(conn *gorm.DB, round *models.Round, usernames []models.WikimediaUsernameType) ([]models.Role, error) {
+	jury_repo := repository.NewRoleRepository()
+	juryType := models.RoleTypeJury
+	filter := &models.RoleFilter{
+		Type:       &juryType,
+		RoundID:    &round.RoundID,
+		CampaignID: &round.CampaignID,
+	}
+	if len(usernames) > 0 {
+		return jury_repo.FindRolesByUsername(conn, usernames, filter)
+	}
+	return jury_repo.ListAllRoles(conn, filter)
+}
+
+// assign writes the allocation of the round to the main database
+func (strategy *CapacityWeightedDistributionStrategy) assign(tx *gorm.DB, round *models.Round, juries []models.Role) (successCount int, failedCount int, err error) {
+	pool, selected, err := strategy.allocate(tx, round, juries, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	// The new judge of the reassigned evaluations, the empty key holds the ones nobody can take
+	reassigned := map[models.IDType][]string{}
+	for _, evaluation := range pool {
+		judgeID := selected[evaluation.EvaluationID]
+		if judgeID == nil {
+			failedCount++
+			if evaluation.JudgeID != nil {
+				reassigned[""] = append(reassigned[""], evaluation.EvaluationID.String())
+			}
+			continue
+		}
+		successCount++
+		if evaluation.JudgeID == nil || *evaluation.JudgeID != *judgeID {
+			reassigned[*judgeID] = append(reassigned[*judgeID], evaluation.EvaluationID.String())
+		}
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	const batchSize = 1000
+	for judgeID, evaluationIds := range reassigned {
+		var judge *models.IDType
+		if judgeID != "" {
+			judge = &judgeID
+		}
+		for start := 0; start < len(evaluationIds); start += batchSize {
+			batch := evaluationIds[start:min(start+batchSize, len(evaluationIds))]
+			if _, err := Evaluation.Where(Evaluation.EvaluationID.In(batch...)).Updates(map[string]any{
+				"judge_id":             judge,
+				"distribution_task_id": strategy.TaskId,
+				"skip_count":           0,
+				"skip_expiration_at":   nil,
+			}); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+	return successCount, failedCount, nil
+}
+
+// allocate hands every unevaluated assignment of the juries (and every unassigned one) to the jury
+// that is furthest behind its quota, among the juries allowed to evaluate the submission.
+// The extra evaluations are not in the database yet, they are allocated along with the others.
+// It returns the redistributed evaluations and the selected judge of each of them (nil if nobody can take it).
+func (strategy *CapacityWeightedDistributionStrategy) allocate(tx *gorm.DB, round *models.Round, juries []models.Role, extra []*models.Evaluation) (pool []*models.Evaluation, selected map[models.IDType]*models.IDType, err error) {
+	participating := map[models.IDType]bool{}
+	for _, jury := range juries {
+		participating[jury.RoleID] = true
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	evaluations, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.SubmissionID, Evaluation.JudgeID, Evaluation.Score, Evaluation.EvaluatedAt).
+		Where(Evaluation.RoundID.Eq(round.RoundID.String())).Order(Evaluation.SubmissionID, Evaluation.EvaluationID).Find()
+	if err != nil {
+		return nil, nil, err
+	}
+	evaluations = append(evaluations, extra...)
+	submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.SubmittedByID, q.Submission.ParticipantID).
+		Where(q.Submission.RoundID.Eq(round.RoundID.String())).Find()
+	if err != nil {
+		return nil, nil, err
+	}
+	submissionMap := map[types.SubmissionIDType]*models.Submission{}
+	for _, submission := range submissions {
+		submissionMap[submission.SubmissionID] = submission
+	}
+	conflicts, err := repository.NewConflictRepository().FetchIndex(tx, round.RoundID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The judges that keep their assignment of a submission
+	taken := map[types.SubmissionIDType]map[models.IDType]bool{}
+	evaluatedCount := map[models.IDType]int{}
+	for _, evaluation := range evaluations {
+		unevaluated := evaluation.Score == nil && evaluation.EvaluatedAt == nil
+		if unevaluated && (evaluation.JudgeID == nil || participating[*evaluation.JudgeID]) {
+			pool = append(pool, evaluation)
+			continue
+		}
+		if evaluation.JudgeID == nil {
+			continue
+		}
+		if taken[evaluation.SubmissionID] == nil {
+			taken[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		taken[evaluation.SubmissionID][*evaluation.JudgeID] = true
+		if !unevaluated {
+			evaluatedCount[*evaluation.JudgeID]++
+		}
+	}
+	quota := capacityQuota(juries, evaluatedCount, len(pool), strategy.DefaultCapacity)
+	log.Println("Capacity weighted quota: ", quota)
+	given := map[models.IDType]int{}
+	selected = map[models.IDType]*models.IDType{}
+	for _, evaluation := range pool {
+		submission := submissionMap[evaluation.SubmissionID]
+		var best *models.Role
+		bestRemaining := 0.0
+		for i := range juries {
+			jury := &juries[i]
+			if taken[evaluation.SubmissionID][jury.RoleID] || conflicts.Conflicts(jury.RoleID, submission) {
+				continue
+			}
+			if submission != nil && (jury.UserID == submission.SubmittedByID || jury.UserID == submission.ParticipantID) {
+				continue
+			}
+			remaining := float64(quota[jury.RoleID]-given[jury.RoleID]) / float64(max(quota[jury.RoleID], 1))
+			if best == nil || remaining > bestRemaining {
+				best = jury
+				bestRemaining = remaining
+			}
+		}
+		if best == nil {
+			selected[evaluation.EvaluationID] = nil
+			continue
+		}
+		given[best.RoleID]++
+		if taken[evaluation.SubmissionID] == nil {
+			taken[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		taken[evaluation.SubmissionID][best.RoleID] = true
+		selected[evaluation.EvaluationID] = &best.RoleID
+	}
+	return pool, selected, nil
+}
+
+// capacityQuota returns the number of new assignments each jury should receive, so that the total workload
+// (evaluated + new) of each jury is proportional to its capacity. A jury without any capacity gets the default capacity
+// if one is given, otherwise the average capacity of the others, and if nobody has a capacity, everyone gets an equal share.
+func capacityQuota(juries []models.Role, evaluatedCount map[models.IDType]int, poolSize int, defaultCapacityParameter int) map[models.IDType]int {
+	quota := map[models.IDType]int{}
+	if len(juries) == 0 || poolSize == 0 {
+		return quota
+	}
+	totalCapacity, withCapacity := 0, 0
+	for _, jury := range juries {
+		if jury.Capacity > 0 {
+			totalCapacity += jury.Capacity
+			withCapacity++
+		}
+	}
+	defaultCapacity := 1.0
+	if defaultCapacityParameter > 0 {
+		defaultCapacity = float64(defaultCapacityParameter)
+	} else if withCapacity > 0 {
+		defaultCapacity = float64(totalCapacity) / float64(withCapacity)
+	}
+	weights := map[models.IDType]float64{}
+	totalWeight := 0.0
+	totalWorkload := poolSize
+	for _, jury := range juries {
+		weight := defaultCapacity
+		if jury.Capacity > 0 {
+			weight = float64(jury.Capacity)
+		}
+		weights[jury.RoleID] = weight
+		totalWeight += weight
+		totalWorkload += evaluatedCount[jury.RoleID]
+	}
+	// What is still missing from the proportional share of each jury
+	need := map[models.IDType]float64{}
+	totalNeed := 0.0
+	for _, jury := range juries {
+		missing := float64(totalWorkload)*weights[jury.RoleID]/totalWeight - float64(evaluatedCount[jury.RoleID])
+		if missing > 0 {
+			need[jury.RoleID] = missing
+			totalNeed += missing
+		}
+	}
+	if totalNeed == 0 {
+		return quota
+	}
+	type remainder struct {
+		roleID   models.IDType
+		fraction float64
+	}
+	remainders := []remainder{}
+	assigned := 0
+	for _, jury := range juries {
+		exact := need[jury.RoleID] * float64(poolSize) / totalNeed
+		quota[jury.RoleID] = int(exact)
+		assigned += quota[jury.RoleID]
+		remainders = append(remainders, remainder{roleID: jury.RoleID, fraction: exact - float64(int(exact))})
+	}
+	// Largest remainder first, so that the quotas add up to the pool
+	sort.SliceStable(remainders, func(i, j int) bool {
+		return remainders[i].fraction > remainders[j].fraction
+	})
+	for i := 0; assigned < poolSize; i++ {
+		quota[remainders[i%len(remainders)].roleID]++
+		assigned++
+	}
+	return quota
+}
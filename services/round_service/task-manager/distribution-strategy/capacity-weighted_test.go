@@ -0,0 +1,66 @@
+package distributionstrategy_test
+
+import (
+	"fmt"
+	"maps"
+	"nokib/campwiz/models"
+	distributionstrategy "nokib/campwiz/services/round_service/task-manager/distribution-strategy"
+	"testing"
+)
+
+func TestCapacityQuota(t *testing.T) {
+	cases := []struct {
+		name            string
+		capacities      []int
+		evaluatedCount  map[models.IDType]int
+		poolSize        int
+		defaultCapacity int
+		expected        map[models.IDType]int
+	}{
+		{
+			// j2 has no capacity, so it gets the average capacity of the others
+			name:       "average capacity",
+			capacities: []int{4, 0},
+			poolSize:   6,
+			expected:   map[models.IDType]int{"j1": 3, "j2": 3},
+		},
+		{
+			name:            "default capacity",
+			capacities:      []int{4, 0},
+			poolSize:        6,
+			defaultCapacity: 2,
+			expected:        map[models.IDType]int{"j1": 4, "j2": 2},
+		},
+		{
+			// j1 has already evaluated more than its share of the total workload
+			name:           "over the share",
+			capacities:     []int{1, 1},
+			evaluatedCount: map[models.IDType]int{"j1": 10},
+			poolSize:       4,
+			expected:       map[models.IDType]int{"j1": 0, "j2": 4},
+		},
+		{
+			name:       "no capacity",
+			capacities: []int{0, 0, 0},
+			poolSize:   5,
+			expected:   map[models.IDType]int{"j1": 2, "j2": 2, "j3": 1},
+		},
+		{
+			name:       "empty pool",
+			capacities: []int{1, 2},
+			expected:   map[models.IDType]int{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			juries := []models.Role{}
+			for i, capacity := range c.capacities {
+				juries = append(juries, models.Role{RoleID: models.IDType(fmt.Sprintf("j%d", i+1)), Capacity: capacity})
+			}
+			quota := distributionstrategy.CapacityQuota(juries, c.evaluatedCount, c.poolSize, c.defaultCapacity)
+			if !maps.Equal(quota, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, quota)
+			}
+		})
+	}
+}
@@ -0,0 +1,447 @@
+package distributionstrategy
+
+import (
+	"context"
+	"errors"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"nokib/campwiz/repository/cache"
+	idgenerator "nokib/campwiz/services/idGenerator"
+
+	"gorm.io/gorm"
+)
+
+// IDistributionPlanner is implemented by the strategies that support dry runs.
+// Plan must never modify the assignments in the main database.
+type IDistributionPlanner interface {
+	Plan(ctx context.Context) (*models.DistributionPlan, error)
+}
+
+// Plan runs the round robin quota calculation of the first version in the task cache database and returns its outcome.
+// The missing evaluations of the submissions under quorum are simulated in the cache as well.
+func (strategy *RoundRobinV1) Plan(ctx context.Context) (*models.DistributionPlan, error) {
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	round, juries, missing, err := prepareDistributionPlan(conn, strategy.RoundId, strategy.SourceJuries)
+	if err != nil {
+		return nil, err
+	}
+	taskDB, closeTaskDB := cache.GetTaskCacheDB(ctx, strategy.TaskId)
+	defer closeTaskDB()
+	totalEvaluations, err := strategy.importToCache(conn, taskDB, round)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		simulated := []*cache.Evaluation{}
+		for _, evaluation := range missing {
+			simulated = append(simulated, &cache.Evaluation{
+				EvaluationID: evaluation.EvaluationID,
+				SubmissionID: evaluation.SubmissionID,
+			})
+		}
+		if res := taskDB.CreateInBatches(simulated, 1000); res.Error != nil {
+			return nil, res.Error
+		}
+		totalEvaluations += len(simulated)
+	}
+	juryIDs := []models.IDType{}
+	for _, jury := range juries {
+		juryIDs = append(juryIDs, jury.RoleID)
+	}
+	workload, err := strategy.calculateWorkloadQuota(taskDB, totalEvaluations, juryIDs, juries)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := strategy.distribute(taskDB, workload); err != nil {
+		return nil, err
+	}
+	planned := []*cache.Evaluation{}
+	if res := taskDB.Find(&planned); res.Error != nil {
+		return nil, res.Error
+	}
+	return summarizeDistributionPlan(conn, round, juries, planned)
+}
+
+// Plan replays the second version of the round robin in memory, the main database is only read.
+// The queries of the distribution are simulated in the order they run: the targets keep up to their share of their own assignments
+// and take the rest from the others, the remaining unassigned ones go to the least loaded juries, and finally the conflicting
+// and the self assignments are handed to another jury.
+func (strategy *RoundRobinDistributionStrategy) Plan(ctx context.Context) (*models.DistributionPlan, error) {
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	targetUsernames := []models.WikimediaUsernameType{}
+	for _, username := range strategy.TargetJuries {
+		targetUsernames = append(targetUsernames, models.WikimediaUsernameType(username))
+	}
+	round, targets, missing, err := prepareDistributionPlan(conn, strategy.RoundId, targetUsernames)
+	if err != nil {
+		return nil, err
+	}
+	juryType := models.RoleTypeJury
+	juries, err := repository.NewRoleRepository().ListAllRoles(conn, &models.RoleFilter{RoundID: &round.RoundID, Type: &juryType})
+	if err != nil {
+		return nil, err
+	}
+	sourceJuries := map[models.IDType]bool{}
+	if len(strategy.SourceJuries) > 0 {
+		sources, err := fetchDistributionJuries(conn, round, strategy.SourceJuries)
+		if err != nil {
+			return nil, err
+		}
+		for _, source := range sources {
+			sourceJuries[source.RoleID] = true
+		}
+	}
+	q := query.Use(conn)
+	Evaluation := q.Evaluation
+	evaluations, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.SubmissionID, Evaluation.JudgeID, Evaluation.Score, Evaluation.EvaluatedAt).
+		Where(Evaluation.RoundID.Eq(round.RoundID.String())).Order(Evaluation.EvaluationID).Find()
+	if err != nil {
+		return nil, err
+	}
+	evaluations = append(evaluations, missing...)
+	submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.SubmittedByID, q.Submission.ParticipantID).
+		Where(q.Submission.RoundID.Eq(round.RoundID.String())).Find()
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := repository.NewConflictRepository().FetchIndex(conn, round.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	simulateRoundRobin2(evaluations, submissions, conflicts, juries, targets, sourceJuries)
+	planned := []*cache.Evaluation{}
+	for _, evaluation := range evaluations {
+		planned = append(planned, &cache.Evaluation{
+			EvaluationID: evaluation.EvaluationID,
+			SubmissionID: evaluation.SubmissionID,
+			JudgeID:      evaluation.JudgeID,
+			Score:        evaluation.Score,
+		})
+	}
+	return summarizeDistributionPlan(conn, round, targets, planned)
+}
+
+// simulateRoundRobin2 changes the judges of the evaluations the way the second version of the round robin would.
+// If there are source juries, the targets only take from them (and from the targets served before), otherwise from anybody.
+func simulateRoundRobin2(evaluations []*models.Evaluation, submissions []*models.Submission, conflicts *models.ConflictIndex,
+	juries []models.Role, targets []models.Role, sourceJuries map[models.IDType]bool) {
+	submissionMap := map[types.SubmissionIDType]*models.Submission{}
+	for _, submission := range submissions {
+		submissionMap[submission.SubmissionID] = submission
+	}
+	// The judges of each submission, including the ones who already evaluated it
+	judges := map[types.SubmissionIDType]map[models.IDType]bool{}
+	assignedCount := map[models.IDType]int{}
+	evaluatedCount := map[models.IDType]int{}
+	pending := func(evaluation *models.Evaluation) bool {
+		return evaluation.Score == nil && evaluation.EvaluatedAt == nil
+	}
+	for _, evaluation := range evaluations {
+		if evaluation.JudgeID == nil {
+			continue
+		}
+		if judges[evaluation.SubmissionID] == nil {
+			judges[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		judges[evaluation.SubmissionID][*evaluation.JudgeID] = true
+		assignedCount[*evaluation.JudgeID]++
+		if !pending(evaluation) {
+			evaluatedCount[*evaluation.JudgeID]++
+		}
+	}
+	moveTo := func(evaluation *models.Evaluation, judgeID *models.IDType) {
+		if evaluation.JudgeID != nil {
+			assignedCount[*evaluation.JudgeID]--
+			delete(judges[evaluation.SubmissionID], *evaluation.JudgeID)
+		}
+		evaluation.JudgeID = judgeID
+		if judgeID == nil {
+			return
+		}
+		assignedCount[*judgeID]++
+		if judges[evaluation.SubmissionID] == nil {
+			judges[evaluation.SubmissionID] = map[models.IDType]bool{}
+		}
+		judges[evaluation.SubmissionID][*judgeID] = true
+	}
+	// settled are the evaluations the task has locked, the later targets do not take them
+	settled := map[models.IDType]bool{}
+	alreadyAssigned := map[models.IDType]int{}
+	totalReassignable := 0
+	isTarget := map[models.IDType]bool{}
+	for _, target := range targets {
+		isTarget[target.RoleID] = true
+		alreadyAssigned[target.RoleID] = assignedCount[target.RoleID]
+		totalReassignable += assignedCount[target.RoleID]
+	}
+	for _, evaluation := range evaluations {
+		if !pending(evaluation) || (evaluation.JudgeID != nil && isTarget[*evaluation.JudgeID]) {
+			continue
+		}
+		if len(sourceJuries) == 0 || (evaluation.JudgeID != nil && sourceJuries[*evaluation.JudgeID]) {
+			totalReassignable++
+		}
+	}
+	for i, target := range targets {
+		average := totalReassignable / (len(targets) - i)
+		evaluated := evaluatedCount[target.RoleID]
+		lockable := max(evaluated, min(average, alreadyAssigned[target.RoleID])) - evaluated
+		locked := 0
+		for _, evaluation := range evaluations {
+			if locked >= lockable {
+				break
+			}
+			if pending(evaluation) && !settled[evaluation.EvaluationID] && evaluation.JudgeID != nil && *evaluation.JudgeID == target.RoleID {
+				settled[evaluation.EvaluationID] = true
+				locked++
+			}
+		}
+		newlyAssigned := 0
+		if average > alreadyAssigned[target.RoleID] {
+			takeFrom := map[models.IDType]bool{}
+			for judgeID := range sourceJuries {
+				takeFrom[judgeID] = true
+			}
+			for _, previous := range targets[:max(i-1, 0)] {
+				takeFrom[previous.RoleID] = true
+			}
+			taken := map[types.SubmissionIDType]bool{}
+			for _, evaluation := range evaluations {
+				if newlyAssigned >= average-alreadyAssigned[target.RoleID] {
+					break
+				}
+				if !pending(evaluation) || settled[evaluation.EvaluationID] || taken[evaluation.SubmissionID] || judges[evaluation.SubmissionID][target.RoleID] {
+					continue
+				}
+				if len(sourceJuries) > 0 && (evaluation.JudgeID == nil || !takeFrom[*evaluation.JudgeID]) {
+					continue
+				}
+				if submission := submissionMap[evaluation.SubmissionID]; submission != nil && submission.SubmittedByID == target.UserID {
+					continue
+				}
+				taken[evaluation.SubmissionID] = true
+				settled[evaluation.EvaluationID] = true
+				moveTo(evaluation, &target.RoleID)
+				newlyAssigned++
+			}
+		}
+		totalReassignable -= evaluated + locked + newlyAssigned
+	}
+	// leastLoaded returns the jury that may evaluate the submission with the fewest evaluated and then assigned evaluations.
+	// The reassignment of the conflicting and the self assignments also honours the conflicts and only looks at the assigned ones.
+	leastLoaded := func(evaluation *models.Evaluation, reassign bool) *models.IDType {
+		submission := submissionMap[evaluation.SubmissionID]
+		var selected *models.Role
+		for i := range juries {
+			jury := &juries[i]
+			if judges[evaluation.SubmissionID][jury.RoleID] {
+				continue
+			}
+			if submission != nil && (jury.UserID == submission.SubmittedByID || jury.UserID == submission.ParticipantID) {
+				continue
+			}
+			if reassign && conflicts.Conflicts(jury.RoleID, submission) {
+				continue
+			}
+			if selected == nil {
+				selected = jury
+				continue
+			}
+			if !reassign && evaluatedCount[jury.RoleID] != evaluatedCount[selected.RoleID] {
+				if evaluatedCount[jury.RoleID] < evaluatedCount[selected.RoleID] {
+					selected = jury
+				}
+				continue
+			}
+			if assignedCount[jury.RoleID] < assignedCount[selected.RoleID] {
+				selected = jury
+			}
+		}
+		if selected == nil {
+			return nil
+		}
+		return &selected.RoleID
+	}
+	for _, evaluation := range evaluations {
+		if pending(evaluation) && evaluation.JudgeID == nil {
+			moveTo(evaluation, leastLoaded(evaluation, false))
+		}
+	}
+	userIds := map[models.IDType]models.IDType{}
+	for _, jury := range juries {
+		userIds[jury.RoleID] = jury.UserID
+	}
+	for _, evaluation := range evaluations {
+		if !pending(evaluation) || evaluation.JudgeID == nil {
+			continue
+		}
+		submission := submissionMap[evaluation.SubmissionID]
+		userID := userIds[*evaluation.JudgeID]
+		if conflicts.Conflicts(*evaluation.JudgeID, submission) ||
+			(submission != nil && (userID == submission.SubmittedByID || userID == submission.ParticipantID)) {
+			moveTo(evaluation, leastLoaded(evaluation, true))
+		}
+	}
+}
+
+// Plan allocates the assignments in memory without writing them back
+func (strategy *CapacityWeightedDistributionStrategy) Plan(ctx context.Context) (*models.DistributionPlan, error) {
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	round, juries, missing, err := prepareDistributionPlan(conn, strategy.RoundId, strategy.SourceJuries)
+	if err != nil {
+		return nil, err
+	}
+	pool, selected, err := strategy.allocate(conn, round, juries, missing)
+	if err != nil {
+		return nil, err
+	}
+	redistributed := map[models.IDType]bool{}
+	planned := []*cache.Evaluation{}
+	for _, evaluation := range pool {
+		redistributed[evaluation.EvaluationID] = true
+		planned = append(planned, &cache.Evaluation{
+			EvaluationID: evaluation.EvaluationID,
+			SubmissionID: evaluation.SubmissionID,
+			JudgeID:      selected[evaluation.EvaluationID],
+		})
+	}
+	q := query.Use(conn)
+	Evaluation := q.Evaluation
+	kept, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.SubmissionID, Evaluation.JudgeID, Evaluation.Score).
+		Where(Evaluation.RoundID.Eq(round.RoundID.String())).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, evaluation := range kept {
+		if redistributed[evaluation.EvaluationID] {
+			continue
+		}
+		planned = append(planned, &cache.Evaluation{
+			EvaluationID: evaluation.EvaluationID,
+			SubmissionID: evaluation.SubmissionID,
+			JudgeID:      evaluation.JudgeID,
+			Score:        evaluation.Score,
+		})
+	}
+	return summarizeDistributionPlan(conn, round, juries, planned)
+}
+
+// prepareDistributionPlan fetches the round and its juries, and simulates the evaluations
+// that the distribution would create for the submissions under quorum
+func prepareDistributionPlan(conn *gorm.DB, roundID models.IDType, usernames []models.WikimediaUsernameType) (*models.Round, []models.Role, []*models.Evaluation, error) {
+	round, err := repository.NewRoundRepository().FindByID(conn, roundID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	juries, err := fetchDistributionJuries(conn, round, usernames)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(juries) == 0 {
+		return nil, nil, nil, errors.New("no juries found")
+	}
+	submissions, err := repository.NewSubmissionRepository().ListAllSubmissions(conn.Where("assignment_count < ?", round.Quorum), &models.SubmissionListFilter{
+		RoundID:    round.RoundID,
+		CampaignID: round.CampaignID,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	missing := []*models.Evaluation{}
+	for _, submission := range submissions {
+		for range round.Quorum - submission.AssignmentCount {
+			missing = append(missing, &models.Evaluation{
+				EvaluationID: idgenerator.GenerateID("e"),
+				SubmissionID: submission.SubmissionID,
+				RoundID:      round.RoundID,
+			})
+		}
+	}
+	log.Printf("Simulating %d missing evaluations", len(missing))
+	return round, juries, missing, nil
+}
+
+// summarizeDistributionPlan counts the planned assignments of each jury and the violations of the plan
+func summarizeDistributionPlan(conn *gorm.DB, round *models.Round, juries []models.Role, planned []*cache.Evaluation) (*models.DistributionPlan, error) {
+	q := query.Use(conn)
+	juryUsers := map[models.IDType]models.IDType{}
+	userIds := []string{}
+	for _, jury := range juries {
+		juryUsers[jury.RoleID] = jury.UserID
+		userIds = append(userIds, jury.UserID.String())
+	}
+	users, err := q.User.Select(q.User.UserID, q.User.Username).Where(q.User.UserID.In(userIds...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	usernames := map[models.IDType]models.WikimediaUsernameType{}
+	for _, user := range users {
+		usernames[user.UserID] = user.Username
+	}
+	submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.SubmittedByID, q.Submission.ParticipantID).
+		Where(q.Submission.RoundID.Eq(round.RoundID.String())).Find()
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := repository.NewConflictRepository().FetchIndex(conn, round.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	submissionMap := map[types.SubmissionIDType]*models.Submission{}
+	assignmentCount := map[types.SubmissionIDType]int{}
+	for _, submission := range submissions {
+		submissionMap[submission.SubmissionID] = submission
+		assignmentCount[submission.SubmissionID] = 0
+	}
+	plan := &models.DistributionPlan{TotalAssignments: int32(len(planned))}
+	plannedCount := map[models.IDType]int32{}
+	for _, evaluation := range planned {
+		if evaluation.JudgeID == nil {
+			plan.UnassignedAssignments++
+			continue
+		}
+		judgeID := *evaluation.JudgeID
+		plannedCount[judgeID]++
+		assignmentCount[evaluation.SubmissionID]++
+		if evaluation.Score != nil {
+			continue
+		}
+		submission := submissionMap[evaluation.SubmissionID]
+		if conflicts.Conflicts(judgeID, submission) {
+			plan.ConflictingAssignments++
+		}
+		if userID, ok := juryUsers[judgeID]; ok && submission != nil && (userID == submission.SubmittedByID || userID == submission.ParticipantID) {
+			plan.SelfAssignments++
+		}
+	}
+	for _, count := range assignmentCount {
+		if count < int(round.Quorum) {
+			plan.UnderQuorumSubmissions++
+		}
+	}
+	for _, jury := range juries {
+		plan.Juries = append(plan.Juries, &models.JuryDistributionPlan{
+			RoleId:             jury.RoleID.String(),
+			Username:           string(usernames[jury.UserID]),
+			CurrentAssignments: int32(jury.TotalAssigned),
+			PlannedAssignments: plannedCount[jury.RoleID],
+			Evaluated:          int32(jury.TotalEvaluated),
+		})
+	}
+	return plan, nil
+}
@@ -0,0 +1,60 @@
+package distributionstrategy_test
+
+import (
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	distributionstrategy "nokib/campwiz/services/round_service/task-manager/distribution-strategy"
+	"testing"
+)
+
+func TestSimulateRoundRobin2(t *testing.T) {
+	judge := func(id models.IDType) *models.IDType { return &id }
+	juries := []models.Role{{RoleID: "j1", UserID: "u1"}, {RoleID: "j2", UserID: "u2"}}
+	submissions := []*models.Submission{}
+	for _, id := range []types.SubmissionIDType{"s1", "s2", "s3", "s4"} {
+		submissions = append(submissions, &models.Submission{SubmissionID: id, SubmittedByID: "u9", ParticipantID: "u9"})
+	}
+	conflictingSubmission := types.SubmissionIDType("s4")
+	cases := []struct {
+		name      string
+		judges    []*models.IDType
+		conflicts *models.ConflictIndex
+		expected  []models.IDType
+	}{
+		{
+			name:     "unassigned",
+			judges:   []*models.IDType{nil, nil, nil, nil},
+			expected: []models.IDType{"j1", "j1", "j2", "j2"},
+		},
+		{
+			// j1 keeps its share and the rest is taken by j2
+			name:     "overloaded",
+			judges:   []*models.IDType{judge("j1"), judge("j1"), judge("j1"), nil},
+			expected: []models.IDType{"j1", "j1", "j2", "j2"},
+		},
+		{
+			name:      "conflict",
+			judges:    []*models.IDType{judge("j1"), judge("j1"), judge("j1"), nil},
+			conflicts: models.NewConflictIndex([]models.ConflictOfInterest{{RoleID: "j2", SubmissionID: &conflictingSubmission}}),
+			expected:  []models.IDType{"j1", "j1", "j2", "j1"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			evaluations := []*models.Evaluation{}
+			for i, submission := range submissions {
+				evaluations = append(evaluations, &models.Evaluation{
+					EvaluationID: models.IDType("e" + submission.SubmissionID.String()),
+					SubmissionID: submission.SubmissionID,
+					JudgeID:      c.judges[i],
+				})
+			}
+			distributionstrategy.SimulateRoundRobin2(evaluations, submissions, c.conflicts, juries, juries, nil)
+			for i, evaluation := range evaluations {
+				if evaluation.JudgeID == nil || *evaluation.JudgeID != c.expected[i] {
+					t.Errorf("expected %s to be assigned to %s, got %v", evaluation.EvaluationID, c.expected[i], evaluation.JudgeID)
+				}
+			}
+		})
+	}
+}
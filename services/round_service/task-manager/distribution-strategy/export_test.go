@@ -0,0 +1,5 @@
+package distributionstrategy
+
+// The pure parts of the strategies, exported for the tests
+var SimulateRoundRobin2 = simulateRoundRobin2
+var CapacityQuota = capacityQuota
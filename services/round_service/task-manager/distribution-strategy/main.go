@@ -1,7 +1,13 @@
 package distributionstrategy
 
 import (
+	"errors"
+	"log"
 	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+
+	"gorm.io/gorm"
 )
 
 type DistributorServer struct {
@@ -12,3 +18,61 @@ type DistributorServer struct {
 func NewDistributorServer() models.DistributorServer {
 	return &DistributorServer{}
 }
+
+// startDistribution fetches the task and the round of a distribution and marks the round as distributing.
+// The returned function must be deferred, it restores the status of the round and records the outcome in the task.
+func startDistribution(conn *gorm.DB, taskID models.IDType, roundID models.IDType) (*models.Task, *models.Round, func(), error) {
+	taskRepo := repository.NewTaskRepository()
+	task, err := taskRepo.FindByID(conn, taskID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if task == nil {
+		return nil, nil, nil, errors.New("task not found")
+	}
+	round_repo := repository.NewRoundRepository()
+	round, err := round_repo.FindByID(conn, roundID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if round == nil {
+		return nil, nil, nil, errors.New("round not found")
+	}
+	if task.AssociatedRoundID == nil || round.RoundID != *task.AssociatedRoundID {
+		return nil, nil, nil, errors.New("round ID mismatch")
+	}
+	previousRoundStatus := round.Status
+	// The previous status is kept with the task as well, so that it can be restored if the distribution never finishes
+	key := models.RoundStatusTaskDataKey
+	if err := conn.Create(&models.TaskData{
+		DataID: idgenerator.GenerateID("d"),
+		TaskID: task.TaskID,
+		Key:    &key,
+		Value:  string(previousRoundStatus),
+	}).Error; err != nil {
+		return nil, nil, nil, err
+	}
+	round.Status = models.RoundStatusDistributing
+	if err := conn.Save(round).Error; err != nil {
+		return nil, nil, nil, err
+	}
+	finish := func() {
+		if _, updateErr := round_repo.Update(conn, &models.Round{
+			RoundID: round.RoundID,
+			Status:  previousRoundStatus,
+		}); updateErr != nil {
+			log.Println("Error: ", updateErr)
+			return
+		}
+		if _, updateErr := taskRepo.Update(conn, &models.Task{
+			TaskID:       task.TaskID,
+			Status:       task.Status,
+			SuccessCount: task.SuccessCount,
+			FailedCount:  task.FailedCount,
+		}); updateErr != nil {
+			log.Println("Error: ", updateErr)
+			return
+		}
+	}
+	return task, round, finish, nil
+}
@@ -11,16 +11,21 @@ import (
 	"gorm.io/gorm"
 )
 
-type Randomizer struct{}
+// Randomizer shuffles the unevaluated assignments of a round among its juries
+type Randomizer struct {
+	RoundId models.IDType
+	TaskId  models.IDType
+}
 
 func (d *DistributorServer) Randomize(ctx context.Context, req *models.DistributeWithRoundRobinRequest) (*models.DistributeWithRoundRobinResponse, error) {
 	roundId := models.IDType(req.RoundId)
-	go randomize(ctx, roundId) //nolint:errcheck
+	// The task of the request is not persisted, so the violations are not reported
+	go randomize(ctx, roundId, nil) //nolint:errcheck
 	return &models.DistributeWithRoundRobinResponse{
 		TaskId: req.TaskId,
 	}, nil
 }
-func randomize(ctx context.Context, roundId models.IDType) error {
+func randomize(ctx context.Context, roundId models.IDType, taskId *models.IDType) error {
 	conn, close, err := repository.GetDB(ctx)
 	if err != nil {
 		return err
@@ -113,6 +118,19 @@ func randomize(ctx context.Context, roundId models.IDType) error {
 			}
 		}
 	}
+	// The swaps above know neither about the declared conflicts nor about the submitters, so resolve them afterwards
+	tx := conn.Begin()
+	if _, err := repository.NewConflictRepository().ReassignConflictingAssignments(tx, round.RoundID, nil); err != nil {
+		log.Println("Error reassigning conflicting evaluations:", err)
+		tx.Rollback()
+		return err
+	}
+	if err := preventSelfEvaluation(tx, round.RoundID, taskId); err != nil {
+		log.Println("Error preventing self evaluations:", err)
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
 	return nil
 }
 
@@ -0,0 +1,187 @@
+package distributionstrategy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"nokib/campwiz/consts"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	StrategyRoundRobin   = "round-robin"
+	StrategyRoundRobinV2 = "round-robin-v2"
+	StrategyRandomize    = "randomize"
+	// StrategyCapacityWeighted splits the assignments proportionally to the capacity of the juries
+	StrategyCapacityWeighted = "capacity-weighted"
+	// DefaultStrategy is used when neither the request, nor the round, nor the configuration names a strategy
+	DefaultStrategy = StrategyRoundRobinV2
+)
+
+// IDistributionStrategy is implemented by all the distribution strategies.
+// Distribute runs in the background, the outcome must be recorded in the task of the request.
+type IDistributionStrategy interface {
+	Distribute(ctx context.Context)
+}
+
+// StrategyFactory builds a strategy out of a distribution request
+type StrategyFactory func(req *models.DistributeRequest) (IDistributionStrategy, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]StrategyFactory{}
+)
+
+// Register makes a strategy available by its name. Registering the same name twice replaces the previous one.
+func Register(name string, factory StrategyFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = factory
+}
+
+// Lookup returns the factory of the named strategy. An empty name resolves to the configured default.
+func Lookup(name string) (string, StrategyFactory, error) {
+	if name == "" {
+		name = DefaultStrategyName()
+	}
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, ok := registry[name]
+	if !ok {
+		return name, nil, fmt.Errorf("unknown distribution strategy: %s", name)
+	}
+	return name, factory, nil
+}
+
+// Names returns the names of all the registered strategies in alphabetical order
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultStrategyName returns the strategy configured as `DistributionStrategy.Algorithm`
+func DefaultStrategyName() string {
+	if consts.Config.Distribution.Strategy != "" {
+		return consts.Config.Distribution.Strategy
+	}
+	return DefaultStrategy
+}
+
+func newRoundRobinStrategy(req *models.DistributeRequest) *RoundRobinDistributionStrategy {
+	sourceJuries := []models.WikimediaUsernameType{}
+	for _, jury := range req.SourceJuryUsernames {
+		sourceJuries = append(sourceJuries, models.WikimediaUsernameType(jury))
+	}
+	return &RoundRobinDistributionStrategy{
+		TaskId:       models.IDType(req.TaskId),
+		SourceJuries: sourceJuries,
+		RoundId:      models.IDType(req.RoundId),
+		TargetJuries: req.TargetJuryUsernames,
+	}
+}
+
+// intParameter reads an integer parameter of the request, zero if it is missing
+func intParameter(req *models.DistributeRequest, name string) (int, error) {
+	value, ok := req.Parameters[name]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s parameter: %s", name, value)
+	}
+	return parsed, nil
+}
+
+// RoundRobinV1 is the first version of the round robin distribution
+type RoundRobinV1 struct {
+	*RoundRobinDistributionStrategy
+}
+
+func (strategy *RoundRobinV1) Distribute(ctx context.Context) {
+	strategy.AssignJuries(ctx)
+}
+func (strategy *RoundRobinDistributionStrategy) Distribute(ctx context.Context) {
+	strategy.AssignJuries2(ctx)
+}
+
+// Distribute swaps the unevaluated assignments of the juries randomly and records the outcome in the task
+func (r *Randomizer) Distribute(ctx context.Context) {
+	status := models.TaskStatusSuccess
+	if err := randomize(ctx, r.RoundId, &r.TaskId); err != nil {
+		log.Println("Error: ", err)
+		status = models.TaskStatusFailed
+	}
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	defer close()
+	if _, err := repository.NewTaskRepository().Update(conn, &models.Task{TaskID: r.TaskId, Status: status}); err != nil {
+		log.Println("Error: ", err)
+	}
+}
+
+func init() {
+	Register(StrategyRoundRobin, func(req *models.DistributeRequest) (IDistributionStrategy, error) {
+		return &RoundRobinV1{newRoundRobinStrategy(req)}, nil
+	})
+	Register(StrategyRoundRobinV2, func(req *models.DistributeRequest) (IDistributionStrategy, error) {
+		return newRoundRobinStrategy(req), nil
+	})
+	Register(StrategyRandomize, func(req *models.DistributeRequest) (IDistributionStrategy, error) {
+		return &Randomizer{RoundId: models.IDType(req.RoundId), TaskId: models.IDType(req.TaskId)}, nil
+	})
+	Register(StrategyCapacityWeighted, func(req *models.DistributeRequest) (IDistributionStrategy, error) {
+		return newCapacityWeightedStrategy(req)
+	})
+}
+
+// Distribute runs the requested strategy in the background, or returns its plan synchronously for a dry run
+func (d *DistributorServer) Distribute(ctx context.Context, req *models.DistributeRequest) (*models.DistributeResponse, error) {
+	name, factory, err := Lookup(req.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun && req.TaskId == "" {
+		// The task cache database is named after the task
+		req.TaskId = string(idgenerator.GenerateID("t"))
+	}
+	strategy, err := factory(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		planner, ok := strategy.(IDistributionPlanner)
+		if !ok {
+			return nil, fmt.Errorf("dry run is not supported by the %s strategy", name)
+		}
+		plan, err := planner.Plan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &models.DistributeResponse{
+			TaskId:   req.TaskId,
+			Strategy: name,
+			Plan:     plan,
+		}, nil
+	}
+	log.Printf("Distributing task %s with %s strategy", req.TaskId, name)
+	go strategy.Distribute(ctx)
+	return &models.DistributeResponse{
+		TaskId:   req.TaskId,
+		Strategy: name,
+	}, nil
+}
@@ -78,61 +78,18 @@ func (d *DistributorServer) DistributeWithRoundRobin(ctx context.Context, req *m
 }
 
 func (strategy *RoundRobinDistributionStrategy) AssignJuries(ctx context.Context) {
-	taskRepo := repository.NewTaskRepository()
 	conn, close, err := repository.GetDB(ctx)
 	if err != nil {
 		log.Println(err)
 		return
 	}
 	defer close()
-	task, err := taskRepo.FindByID(conn, strategy.TaskId)
+	task, round, finish, err := startDistribution(conn, strategy.TaskId, strategy.RoundId)
 	if err != nil {
-		return
-	}
-	if task == nil {
-		log.Println("Task not found")
-		return
-	}
-	round_repo := repository.NewRoundRepository()
-	round, err := round_repo.FindByID(conn, strategy.RoundId)
-	if err != nil {
-		log.Println("Round not found")
-		return
-	}
-	if round == nil {
-		log.Println("Round not found")
-		return
-	}
-	if round.RoundID != *task.AssociatedRoundID {
-		log.Println("Round ID mismatch")
-		return
-	}
-
-	previousRoundStatus := round.Status
-	round.Status = models.RoundStatusDistributing
-	if err := conn.Save(round).Error; err != nil {
 		log.Println("Error: ", err)
 		return
 	}
-	defer func() {
-		if _, updateErr := round_repo.Update(conn, &models.Round{
-			RoundID: round.RoundID,
-			Status:  previousRoundStatus,
-		}); updateErr != nil {
-			log.Println("Error: ", updateErr)
-			return
-		}
-
-		if _, updateErr := taskRepo.Update(conn, &models.Task{
-			TaskID:       task.TaskID,
-			Status:       task.Status,
-			SuccessCount: task.SuccessCount,
-			FailedCount:  task.FailedCount,
-		}); updateErr != nil {
-			log.Println("Error: ", updateErr)
-			return
-		}
-	}()
+	defer finish()
 
 	submission_repo := repository.NewSubmissionRepository()
 	jury_repo := repository.NewRoleRepository()
@@ -214,13 +171,26 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries(ctx context.Context
 		log.Println("Error: ", err)
 		return
 	}
+	// The juries must never get the submissions they have declared a conflict with
+	_, err = repository.NewConflictRepository().ReassignConflictingAssignments(tx, round.RoundID, &strategy.TaskId)
+	if err != nil {
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	err = preventSelfEvaluation(tx, round.RoundID, &strategy.TaskId)
+	if err != nil {
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
 	err = strategy.triggerStatisticsUpdateByRoundID(tx, round)
 	if err != nil {
 		task.Status = models.TaskStatusFailed
 		log.Println("Error: ", err)
 		return
 	}
-	go randomize(ctx, strategy.RoundId) //nolint:errcheck
+	go randomize(ctx, strategy.RoundId, &strategy.TaskId) //nolint:errcheck
 }
 func (strategy *RoundRobinDistributionStrategy) createMissingEvaluations(tx *gorm.DB, evtype models.EvaluationType, round *models.Round, req []models.Submission) (int, error) {
 	evaluations := []models.Evaluation{}
@@ -395,7 +365,26 @@ func (strategy *RoundRobinDistributionStrategy) exportFromCache2MainDB(cache *go
 			return successCount, failedCount, err
 		}
 		log.Printf("Assignments %d\n", len(assignments))
+		evaluationIds := []string{}
 		for _, assignment := range assignments {
+			evaluationIds = append(evaluationIds, assignment.EvaluationID.String())
+		}
+		MainEvaluation := query.Use(tx).Evaluation
+		current, err := MainEvaluation.Select(MainEvaluation.EvaluationID, MainEvaluation.JudgeID).Where(MainEvaluation.EvaluationID.In(evaluationIds...)).Find()
+		if err != nil {
+			return 0, 0, err
+		}
+		currentJudges := map[models.IDType]*models.IDType{}
+		for _, evaluation := range current {
+			currentJudges[evaluation.EvaluationID] = evaluation.JudgeID
+		}
+		for _, assignment := range assignments {
+			lastId = assignment.EvaluationID.String()
+			// Only the moved assignments are attributed to the task, the others keep the task that had assigned them
+			currentJudge := currentJudges[assignment.EvaluationID]
+			if assignment.JudgeID == nil || (currentJudge != nil && *currentJudge == *assignment.JudgeID) {
+				continue
+			}
 			res := tx.Updates(&models.Evaluation{
 				EvaluationID:       assignment.EvaluationID,
 				JudgeID:            assignment.JudgeID,
@@ -404,7 +393,6 @@ func (strategy *RoundRobinDistributionStrategy) exportFromCache2MainDB(cache *go
 			if res.Error != nil {
 				return 0, 0, res.Error
 			}
-			lastId = assignment.EvaluationID.String()
 		}
 	}
 }
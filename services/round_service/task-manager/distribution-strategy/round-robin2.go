@@ -15,7 +15,6 @@ import (
 	"gorm.io/gorm"
 )
 
-// Prevent Self evaluation SQL: update evaluations u1 join (select judge_id, evaluation_id, name from evaluations join submissions join roles on evaluations.submission_id = submissions.submission_id and evaluations.judge_id = roles.role_id  where submitted_by_id=roles.user_id and evaluations.round_id='r2eczdvrjl2ps') u2 using(evaluation_id) set u1.judge_id = (select role_id from roles where role_id <> u2.judge_id and round_id='r2eczdvrjl2ps' and role_id not in (select judge_id from evaluations where submission_id = u1.submission_id) order by rand() limit 1) where round_id='r2eczdvrjl2ps' and score is null;
 // This method would distribute all the evaluations to the juries in round robin fashion
 func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Context) {
 	log.Println("Assigning juries in round robin fashion version 2")
@@ -29,7 +28,6 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	})
 	defer parentSpan.Finish()
 
-	taskRepo := repository.NewTaskRepository()
 	submission_repo := repository.NewSubmissionRepository()
 	conn, close, err := repository.GetDB(ctx)
 	if err != nil {
@@ -37,54 +35,12 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 		return
 	}
 	defer close()
-	task, err := taskRepo.FindByID(conn, strategy.TaskId)
+	task, round, finish, err := startDistribution(conn, strategy.TaskId, strategy.RoundId)
 	if err != nil {
-		return
-	}
-	if task == nil {
-		log.Println("Task not found")
-		return
-	}
-	round_repo := repository.NewRoundRepository()
-	round, err := round_repo.FindByID(conn, strategy.RoundId)
-	if err != nil {
-		log.Println("Round not found")
-		return
-	}
-	if round == nil {
-		log.Println("Round not found")
-		return
-	}
-	if round.RoundID != *task.AssociatedRoundID {
-		log.Println("Round ID mismatch")
-		return
-	}
-
-	previousRoundStatus := round.Status
-	round.Status = models.RoundStatusDistributing
-	if err := conn.Save(round).Error; err != nil {
 		log.Println("Error: ", err)
 		return
 	}
-	defer func() {
-		if _, updateErr := round_repo.Update(conn, &models.Round{
-			RoundID: round.RoundID,
-			Status:  previousRoundStatus,
-		}); updateErr != nil {
-			log.Println("Error: ", updateErr)
-			return
-		}
-
-		if _, updateErr := taskRepo.Update(conn, &models.Task{
-			TaskID:       task.TaskID,
-			Status:       task.Status,
-			SuccessCount: task.SuccessCount,
-			FailedCount:  task.FailedCount,
-		}); updateErr != nil {
-			log.Println("Error: ", updateErr)
-			return
-		}
-	}()
+	defer finish()
 	submissions, err := submission_repo.ListAllSubmissions(conn.Where("assignment_count < ?", round.Quorum), &models.SubmissionListFilter{
 		RoundID:    round.RoundID,
 		CampaignID: round.CampaignID,
@@ -103,7 +59,25 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	}
 	parentSpan.SetData("submission_count_created_missing_evaluations", createdCount)
 	log.Println("Created missing evaluations: ", createdCount)
-	q := query.Use(conn)
+	tx := conn.Begin()
+	successCount, err := strategy.assignJuries2(ctx, tx, round, parentSpan)
+	log.Println("Distributing finished")
+	if err != nil {
+		tx.Rollback()
+		task.Status = models.TaskStatusFailed
+		log.Println("Error: ", err)
+		return
+	}
+	log.Println("Committing transaction")
+	tx.Commit()
+	task.SuccessCount += successCount
+	task.Status = models.TaskStatusSuccess
+}
+
+// assignJuries2 distributes the unevaluated assignments of the round among the target juries within the transaction.
+// It returns the number of the remaining evaluations that were handed out at the end.
+func (strategy *RoundRobinDistributionStrategy) assignJuries2(ctx context.Context, tx *gorm.DB, round *models.Round, parentSpan *sentry.Span) (int, error) {
+	q := query.Use(tx)
 	Role := q.Role
 	User := q.User
 	//Target
@@ -111,9 +85,8 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	targetUsernames := strategy.TargetJuries
 	targetRoles, err := Role.Select(Role.RoleID, Role.UserID, Role.TotalAssigned).Join(User, Role.UserID.EqCol(User.UserID)).Where(Role.RoundID.Eq(strategy.RoundId.String()), User.Username.In(targetUsernames...)).Limit(len(targetUsernames)).Find()
 	if err != nil {
-		task.Status = models.TaskStatusFailed
 		log.Println("Error: ", err)
-		return
+		return 0, err
 	}
 	targetRoleIds := make([]string, len(targetRoles))
 	for i, targetRole := range targetRoles {
@@ -128,8 +101,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	sourceRoles, err := Role.Select(Role.RoleID).Join(User, Role.UserID.EqCol(User.UserID)).Where(Role.RoundID.Eq(strategy.RoundId.String()), User.Username.In(sourceUsernames...)).Limit(len(sourceUsernames)).Find()
 	if err != nil {
 		log.Println("Error: ", err)
-		task.Status = models.TaskStatusFailed
-		return
+		return 0, err
 	}
 	sourceRoleIds := make([]string, len(sourceRoles))
 	for i, sourceRole := range sourceRoles {
@@ -139,24 +111,13 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	includeFromSourceOnly := len(sourceRoleIds) > 0
 	parentSpan.SetData("whether_include_from_source_only", includeFromSourceOnly)
 	log.Println("Include from source only: ", includeFromSourceOnly)
-	tx := conn.Begin()
-	defer func() {
-		log.Println("Distributing finished")
-		if err != nil {
-			tx.Rollback()
-			task.Status = models.TaskStatusFailed
-			log.Println("Error: ", err)
-		} else {
-			log.Println("Committing transaction")
-			tx.Commit()
-			task.Status = models.TaskStatusSuccess
-		}
-	}()
 	q1 := query.Use(tx)
 
 	// /////////////////////////////////////////////////////////
 	Assignment := q1.Evaluation
 	roundId := strategy.RoundId
+	// The assignments the targets keep are locked with the task as well, they are released at the end
+	kept := map[keptAssignment][]string{}
 	for range 1 {
 		alreadyAssignedWorkloads := MinimumWorkloadHeap{}
 		alreadyAssignedToTargetJury := map[models.IDType]WorkLoadType{}
@@ -164,7 +125,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 			Where(Assignment.RoundID.Eq(roundId.String())).Where(Assignment.JudgeID.In(targetRoleIds...)).
 			Group(Assignment.JudgeID).Scan(&alreadyAssignedWorkloads)
 		if err != nil {
-			return
+			return 0, err
 		}
 		totalAlreadyAssignedtoTargetJuryCount := WorkLoadType(0)
 		for _, workload := range alreadyAssignedWorkloads {
@@ -187,7 +148,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 		err = stmt.Scan(&evaluatedByTarget)
 		if err != gorm.ErrRecordNotFound && err != nil {
 			log.Println("Error: ", err)
-			return
+			return 0, err
 		}
 		parentSpan.SetData("evaluated_assignment_count", len(evaluatedByTarget))
 		for _, juror := range evaluatedByTarget {
@@ -206,7 +167,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 		err = stmt.Scan(&transferableAssignmentCount)
 		if err != gorm.ErrRecordNotFound && err != nil {
 			log.Println("Error: ", err)
-			return
+			return 0, err
 		}
 
 		totalTransferableEvaluations := 0
@@ -237,8 +198,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 				Where(q1.Evaluation.DistributionTaskID.Eq(strategy.TaskId.String())).Scan(&b)
 			if err != nil {
 				log.Println("Error: ", err)
-				task.Status = models.TaskStatusFailed
-				return
+				return 0, err
 			}
 			log.Printf("\n\n\tCurrently locked evaluations for target role %d: %d", i, b.Count)
 
@@ -274,19 +234,31 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 			locked := int64(0)
 			if lockableEvaluations > 0 {
 				log.Printf("Locking assigned workload for target judge %s: %d", targetJudgeId, lockableEvaluations)
-				stmt := Evaluation.Where(Evaluation.JudgeID.Eq(targetJudgeId.String())).
+				lockable, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.DistributionTaskID).
+					Where(Evaluation.JudgeID.Eq(targetJudgeId.String())).
 					Where(Evaluation.RoundID.Eq(strategy.RoundId.String())).
 					Where(Evaluation.Score.IsNull()).
 					Where(Evaluation.EvaluatedAt.IsNull()).
 					Where(Evaluation.Where(Evaluation.DistributionTaskID.Neq(strategy.TaskId.String())).Or(Evaluation.DistributionTaskID.IsNull())).
-					Limit(int(lockableEvaluations))
-				res, err := stmt.UpdateColumn(Evaluation.DistributionTaskID, strategy.TaskId)
+					Limit(int(lockableEvaluations)).Find()
 				if err != nil {
 					log.Println("Error locking workload: ", err)
-					task.Status = models.TaskStatusFailed
-					return
+					return 0, err
+				}
+				lockableIds := []string{}
+				for _, evaluation := range lockable {
+					lockableIds = append(lockableIds, evaluation.EvaluationID.String())
+					key := keptAssignment{judgeID: targetJudgeId, previousTaskID: evaluation.DistributionTaskID}
+					kept[key] = append(kept[key], evaluation.EvaluationID.String())
+				}
+				if len(lockableIds) > 0 {
+					res, err := Evaluation.Where(Evaluation.EvaluationID.In(lockableIds...)).UpdateColumn(Evaluation.DistributionTaskID, strategy.TaskId)
+					if err != nil {
+						log.Println("Error locking workload: ", err)
+						return 0, err
+					}
+					locked = res.RowsAffected
 				}
-				locked = res.RowsAffected
 			}
 			if avgWorkload > int(alreadyAssigned) {
 				// add the difference to the workload
@@ -301,8 +273,7 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 				}
 				if err != nil {
 					log.Println("Error: ", err)
-					task.Status = models.TaskStatusFailed
-					return
+					return 0, err
 				}
 				totalReassignableEvaluations -= alreadyEvaluated + WorkLoadType(locked) + WorkLoadType(newlyAssigned)
 			} else if avgWorkload <= int(alreadyAssigned) {
@@ -318,16 +289,57 @@ func (strategy *RoundRobinDistributionStrategy) AssignJuries2(ctx context.Contex
 	}
 	err = strategy.triggerStatisticsUpdateByRoundID(tx, round)
 	if err != nil {
-		task.Status = models.TaskStatusFailed
 		log.Println("Error: ", err)
-		return
+		return 0, err
 	}
 	affected, err := q1.Evaluation.WithContext(ctx).DistributeTheLastRemainingEvaluations(strategy.TaskId, strategy.RoundId.String())
 	if err != nil {
 		log.Println("Error: ", err)
-		task.Status = models.TaskStatusFailed
-		return
+		return 0, err
 	}
 	log.Printf("Total affected evaluations: %d", affected)
-	task.SuccessCount += int(affected)
+	// The juries must never get the submissions they have declared a conflict with
+	reassigned, err := repository.NewConflictRepository().ReassignConflictingAssignments(tx, round.RoundID, &strategy.TaskId)
+	if err != nil {
+		log.Println("Error: ", err)
+		return 0, err
+	}
+	log.Printf("Reassigned conflicting evaluations: %d", reassigned)
+	err = preventSelfEvaluation(tx, round.RoundID, &strategy.TaskId)
+	if err != nil {
+		log.Println("Error: ", err)
+		return 0, err
+	}
+	if err := releaseKeptAssignments(tx, strategy.TaskId, kept); err != nil {
+		log.Println("Error: ", err)
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// keptAssignment groups the assignments a target has kept by the task that had assigned them
+type keptAssignment struct {
+	judgeID        models.IDType
+	previousTaskID models.IDType
+}
+
+// releaseKeptAssignments gives the assignments that are still with the jury who had them back to the task that had assigned them,
+// so that only the moved assignments are attributed to the task (e.g. when it is undone)
+func releaseKeptAssignments(tx *gorm.DB, taskID models.IDType, kept map[keptAssignment][]string) error {
+	Evaluation := query.Use(tx).Evaluation
+	const batchSize = 1000
+	for key, evaluationIds := range kept {
+		var previousTaskID *models.IDType
+		if key.previousTaskID != "" {
+			previousTaskID = &key.previousTaskID
+		}
+		for start := 0; start < len(evaluationIds); start += batchSize {
+			batch := evaluationIds[start:min(start+batchSize, len(evaluationIds))]
+			if _, err := Evaluation.Where(Evaluation.EvaluationID.In(batch...), Evaluation.JudgeID.Eq(key.judgeID.String()),
+				Evaluation.DistributionTaskID.Eq(taskID.String())).Updates(map[string]any{"distribution_task_id": previousTaskID}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
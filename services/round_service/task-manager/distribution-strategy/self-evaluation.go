@@ -0,0 +1,49 @@
+package distributionstrategy
+
+import (
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+
+	"gorm.io/gorm"
+)
+
+const selfEvaluationTaskDataKey = "self-evaluation"
+
+// preventSelfEvaluation is run after every distribution. It reassigns the unevaluated assignments
+// of the juries to their own submissions and then verifies that none is left. The remaining violations
+// (e.g. already evaluated ones) are reported as the output of the task, if any.
+func preventSelfEvaluation(tx *gorm.DB, roundID models.IDType, taskID *models.IDType) error {
+	conflict_repo := repository.NewConflictRepository()
+	reassigned, err := conflict_repo.ReassignSelfEvaluations(tx, roundID, taskID)
+	if err != nil {
+		return err
+	}
+	if reassigned > 0 {
+		log.Printf("Reassigned %d self evaluations of round %s", reassigned, roundID)
+	}
+	violations, err := conflict_repo.FindSelfEvaluations(tx, roundID, false)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	log.Printf("Found %d self evaluations in round %s after the distribution", len(violations), roundID)
+	if taskID == nil {
+		return nil
+	}
+	key := selfEvaluationTaskDataKey
+	data := []models.TaskData{}
+	for _, violation := range violations {
+		data = append(data, models.TaskData{
+			DataID:   idgenerator.GenerateID("d"),
+			TaskID:   *taskID,
+			Key:      &key,
+			Value:    violation.EvaluationID.String(),
+			IsOutput: true,
+		})
+	}
+	return tx.Create(&data).Error
+}
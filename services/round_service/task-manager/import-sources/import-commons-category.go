@@ -10,16 +10,24 @@ import (
 )
 
 type CommonsCategoryListSource struct {
-	Categories           []string
+	Categories []string
+	// Depth is the number of the levels of the subcategories to traverse, 0 imports only the given categories
+	Depth int
+	// The files directly in these categories are skipped and these categories are not traversed
+	ExcludedCategories   []string
 	currentCategoryIndex int
 	lastPageID           uint64
-	commons_repo         *repository.CommonsRepository
+	expanded             bool
+	failed               bool
+	// seen is used to import a file only once when it is in several of the categories
+	seen         map[uint64]struct{}
+	commons_repo *repository.CommonsRepository
 }
 
 func (t *ImporterServer) ImportFromCommonsCategory(ctx context.Context, req *models.ImportFromCommonsCategoryRequest) (*models.ImportResponse, error) {
 	log.Printf("ImportFromCommonsCategory %v", req)
 
-	commonsCategoryLister := NewCommonsCategoryListSource(req.CommonsCategory)
+	commonsCategoryLister := NewCommonsCategoryListSource(req.CommonsCategory, int(req.Depth), req.ExcludedCategories)
 	go t.importFrom(ctx, commonsCategoryLister, req.TaskId, req.RoundId)
 	return &models.ImportResponse{
 		TaskId:  req.TaskId,
@@ -28,38 +36,103 @@ func (t *ImporterServer) ImportFromCommonsCategory(ctx context.Context, req *mod
 }
 
 // ImportImageResults imports images from commons categories
-// For Each invocation it will import images from a single category
+// On the first invocation the categories are expanded with their subcategories up to the depth
+// For Each invocation it will import a batch of images from a single category
 // If all categories are imported it will return nil
-// If there are no images in the category it will return nil
-// If there are images in the category it will return the images
+// If there are images in the category it will return the images which were not imported before
+// and which are not in any of the excluded categories
 // If there are failed images in the category it will return the reason as value of the map
 func (c *CommonsCategoryListSource) ImportImageResults(ctx context.Context, currentRound *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string) {
-	if c.currentCategoryIndex < len(c.Categories) {
-		category := c.Categories[c.currentCategoryIndex]
-		campaign := currentRound.Campaign
-		successMedia, currentfailedImages, lastPageID := c.commons_repo.GetImagesFromCommonsCategories2(ctx, category, c.lastPageID, currentRound, campaign.StartDate, campaign.EndDate)
-		if lastPageID == 0 {
-			c.currentCategoryIndex++
+	if !c.expanded {
+		categories, err := c.commons_repo.ExpandCommonsCategories(ctx, c.Categories, c.ExcludedCategories, c.Depth)
+		if err != nil {
+			log.Printf("Error expanding the categories: %s", err)
+			(*failedImageReason)["*"] = "Failed to fetch the subcategories: " + err.Error()
+			c.failed = true
+			return nil, failedImageReason
 		}
-		c.lastPageID = lastPageID
-		maps.Copy(*failedImageReason, currentfailedImages)
-		return successMedia, failedImageReason
+		log.Printf("Importing from %d categories", len(categories))
+		c.Categories = categories
+		c.expanded = true
 	}
+	if c.currentCategoryIndex >= len(c.Categories) {
+		return nil, failedImageReason
+	}
+	category := c.Categories[c.currentCategoryIndex]
+	campaign := currentRound.Campaign
+	successMedia, currentfailedImages, lastPageID := c.commons_repo.GetImagesFromCommonsCategories2(ctx, category, c.lastPageID, currentRound, campaign.StartDate, campaign.EndDate)
+	if lastPageID == 0 {
+		c.currentCategoryIndex++
+	}
+	c.lastPageID = lastPageID
+	maps.Copy(*failedImageReason, currentfailedImages)
+	successMedia, err := c.filter(ctx, successMedia, failedImageReason)
+	if err != nil {
+		log.Printf("Error filtering the excluded files: %s", err)
+		(*failedImageReason)["*"] = "Failed to check the excluded categories: " + err.Error()
+		c.failed = true
+		return nil, failedImageReason
+	}
+	return successMedia, failedImageReason
+}
 
-	return nil, failedImageReason
+// Done reports whether all the categories have been imported, a category can be empty or only contain excluded files
+func (c *CommonsCategoryListSource) Done() bool {
+	return c.failed || c.currentCategoryIndex >= len(c.Categories)
 }
 
-func NewCommonsCategoryListSource(categories []string) *CommonsCategoryListSource {
+// filter removes the files which were already imported from another category and the ones in the excluded categories
+func (c *CommonsCategoryListSource) filter(ctx context.Context, media []models.MediaResult, failedImageReason *map[string]string) ([]models.MediaResult, error) {
+	unique := []models.MediaResult{}
+	pageIds := []uint64{}
+	for _, m := range media {
+		if _, ok := c.seen[m.PageID]; ok {
+			continue
+		}
+		c.seen[m.PageID] = struct{}{}
+		unique = append(unique, m)
+		pageIds = append(pageIds, m.PageID)
+	}
+	if len(c.ExcludedCategories) == 0 {
+		return unique, nil
+	}
+	excluded, err := c.commons_repo.FetchPagesInCategories(ctx, c.ExcludedCategories, pageIds)
+	if err != nil {
+		return nil, err
+	}
+	result := []models.MediaResult{}
+	for _, m := range unique {
+		if category, ok := excluded[m.PageID]; ok {
+			(*failedImageReason)[m.Name] = "In the excluded category " + category
+			continue
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// normalizeCategory converts a category to the `cl_to` format of the replica
+func normalizeCategory(category string) string {
+	categoryWithUnderscores := strings.ReplaceAll(strings.TrimSpace(category), " ", "_")
+	return strings.TrimPrefix(categoryWithUnderscores, "Category:")
+}
+
+func NewCommonsCategoryListSource(categories []string, depth int, excludedCategories []string) *CommonsCategoryListSource {
 	normalizedCategories := []string{}
 	for _, category := range categories {
-		categoryWithUnderscores := strings.ReplaceAll(category, " ", "_")
-		categoryWithUnderscores = strings.ReplaceAll(categoryWithUnderscores, "Category:", "")
-		normalizedCategories = append(normalizedCategories, categoryWithUnderscores)
+		normalizedCategories = append(normalizedCategories, normalizeCategory(category))
+	}
+	normalizedExclusions := []string{}
+	for _, category := range excludedCategories {
+		normalizedExclusions = append(normalizedExclusions, normalizeCategory(category))
 	}
 	return &CommonsCategoryListSource{
 		Categories:           normalizedCategories,
+		Depth:                depth,
+		ExcludedCategories:   normalizedExclusions,
 		currentCategoryIndex: 0,
 		lastPageID:           0,
+		seen:                 map[uint64]struct{}{},
 		commons_repo:         repository.NewCommonsRepository(nil),
 	}
 }
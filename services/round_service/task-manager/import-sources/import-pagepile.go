@@ -1,8 +1,208 @@
-// This integration would require the following:
-// pagepile id
-// target round id
-// Pagepile sqlite is located at /data/project/shared/pagepile/
-// But Unfortunately Pagepile do not save page id, it only
-// saves the title
-// So, we have to implement the file name to page id
 package importsources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const (
+	pagePileDirectory = "/data/project/shared/pagepile/"
+	// Titles are not indexed as well as the page IDs, so the batches are smaller than the CSV ones
+	titleBatchSize = 5000
+	fileNamespace  = 6
+)
+
+// PagePileSource imports the files of a PagePile from its dump in the shared PagePile directory.
+// The dumps only keep the titles of the pages, so they are resolved to the files on the Commons replica.
+type PagePileSource struct {
+	pagePileID int64
+	path       string
+	titles     []string
+	index      int
+	loaded     bool
+	failed     bool
+}
+
+// pagePileJSON is the format of the `get_data` action of the PagePile API
+type pagePileJSON struct {
+	ID    int64    `json:"id"`
+	Wiki  string   `json:"wiki"`
+	Pages []string `json:"pages"`
+}
+
+// pagePileRow is a row of the `pages` table of a PagePile SQLite dump
+type pagePileRow struct {
+	Page string
+	Ns   int
+}
+
+func (t *ImporterServer) ImportFromPagePile(ctx context.Context, req *models.ImportFromPagePileRequest) (*models.ImportResponse, error) {
+	source, err := NewPagePileSource(req.PagepileId)
+	if err != nil {
+		return nil, err
+	}
+	go t.importFrom(context.Background(), source, req.TaskId, req.RoundId)
+	return &models.ImportResponse{}, nil
+}
+
+// NewPagePileSource reads the SQLite dump of the pile from the shared PagePile directory,
+// or its JSON dump if there is no SQLite one
+func NewPagePileSource(pagePileID int64) (*PagePileSource, error) {
+	for _, extension := range []string{"sqlite", "json"} {
+		path := filepath.Join(pagePileDirectory, fmt.Sprintf("pagepile%d.%s", pagePileID, extension))
+		if _, err := os.Stat(path); err == nil {
+			return &PagePileSource{
+				pagePileID: pagePileID,
+				path:       path,
+			}, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("pagepile %d not found", pagePileID)
+}
+
+// normalizeFileTitle converts a title to the `page_title` format of the replica,
+// i.e. without the namespace prefix and with underscores instead of spaces
+func normalizeFileTitle(title string) string {
+	title = strings.TrimSpace(title)
+	if i := strings.Index(title, ":"); i != -1 {
+		prefix := strings.ToLower(title[:i])
+		if prefix == "file" || prefix == "image" {
+			title = title[i+1:]
+		}
+	}
+	return strings.ReplaceAll(strings.TrimSpace(title), " ", "_")
+}
+
+func (t *PagePileSource) readJSON() ([]string, error) {
+	fp, err := os.Open(t.path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close() //nolint:errcheck
+	pile := &pagePileJSON{}
+	if err := json.NewDecoder(fp).Decode(pile); err != nil {
+		return nil, err
+	}
+	if pile.Wiki != "" && pile.Wiki != "commonswiki" {
+		return nil, fmt.Errorf("pagepile %d belongs to %s, only commonswiki is supported", t.pagePileID, pile.Wiki)
+	}
+	titles := []string{}
+	for _, page := range pile.Pages {
+		// The JSON dump keeps the namespace prefix, so anything that is not a file is skipped
+		lower := strings.ToLower(page)
+		if !strings.HasPrefix(lower, "file:") && !strings.HasPrefix(lower, "image:") {
+			continue
+		}
+		titles = append(titles, normalizeFileTitle(page))
+	}
+	return titles, nil
+}
+func (t *PagePileSource) readSQLite() ([]string, error) {
+	// The dumps are shared, so they are opened read-only to never create or modify one
+	conn, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=ro", t.path)), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if db, err := conn.DB(); err == nil {
+		defer db.Close() //nolint:errcheck
+	}
+	rows := []pagePileRow{}
+	if res := conn.Table("pages").Select("page", "ns").Where("ns = ?", fileNamespace).Find(&rows); res.Error != nil {
+		return nil, res.Error
+	}
+	titles := []string{}
+	for _, row := range rows {
+		titles = append(titles, normalizeFileTitle(row.Page))
+	}
+	return titles, nil
+}
+
+// load reads the titles of the pile once, removing the duplicates
+func (t *PagePileSource) load() error {
+	var titles []string
+	var err error
+	if strings.EqualFold(filepath.Ext(t.path), ".json") {
+		titles, err = t.readJSON()
+	} else {
+		titles, err = t.readSQLite()
+	}
+	if err != nil {
+		return err
+	}
+	seen := map[string]struct{}{}
+	t.titles = []string{}
+	for _, title := range titles {
+		if title == "" {
+			continue
+		}
+		if _, ok := seen[title]; ok {
+			continue
+		}
+		seen[title] = struct{}{}
+		t.titles = append(t.titles, title)
+	}
+	t.loaded = true
+	log.Printf("Loaded %d files from pagepile %d", len(t.titles), t.pagePileID)
+	return nil
+}
+
+// ImportImageResults resolves the next batch of titles to the files on the Commons replica.
+// The titles which are not found (e.g. deleted files) are reported as failed.
+func (t *PagePileSource) ImportImageResults(ctx context.Context, currentRound *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string) {
+	if !t.loaded {
+		if err := t.load(); err != nil {
+			log.Printf("Error reading pagepile %d: %s", t.pagePileID, err)
+			(*failedImageReason)["*"] = "Failed to read pagepile: " + err.Error()
+			t.failed = true
+			return nil, failedImageReason
+		}
+	}
+	if t.index >= len(t.titles) {
+		return nil, failedImageReason
+	}
+	q, close := repository.GetCommonsReplicaWithGen(ctx)
+	defer close()
+	endIndex := min(t.index+titleBatchSize, len(t.titles))
+	batch := t.titles[t.index:endIndex]
+	log.Printf("Processing pagepile titles from index %d to %d", t.index, endIndex)
+	t.index = endIndex
+	data, err := q.CommonsSubmissionEntry.FetchSubmissionsFromCommonsDBByTitle(batch, len(batch))
+	if err != nil {
+		log.Printf("Error importing images by title: %s", err)
+		(*failedImageReason)["*"] = err.Error()
+		t.failed = true
+		return nil, failedImageReason
+	}
+	result := []models.MediaResult{}
+	found := map[string]struct{}{}
+	for _, submission := range data {
+		found[submission.PageTitle] = struct{}{}
+		result = append(result, commonsEntryToMediaResult(submission))
+	}
+	for _, title := range batch {
+		if _, ok := found[title]; !ok {
+			(*failedImageReason)[title] = "File not found on Commons"
+		}
+	}
+	return result, failedImageReason
+}
+
+// Done reports whether all the titles of the pile have been resolved, a batch of missing files is empty
+func (t *PagePileSource) Done() bool {
+	return t.failed || t.index >= len(t.titles)
+}
@@ -0,0 +1,180 @@
+package importsources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"os"
+)
+
+// The page IDs are the primary key of the page table, so the batches can be as big as the CSV ones
+const petScanBatchSize = 15000
+
+type PetScanSource struct {
+	path      string
+	temporary bool
+	pageIds   []uint64
+	// titles of the files listed without a page ID, resolved after the page IDs
+	titles []string
+	index  int
+	loaded bool
+	failed bool
+}
+
+// petScanPage is a page of a PetScan result. The default output uses `id`, `namespace` and `title`
+// while the Quick Intersection compatible output uses the `page_` prefixed ones.
+type petScanPage struct {
+	ID            uint64 `json:"id"`
+	Namespace     *int   `json:"namespace"`
+	Title         string `json:"title"`
+	PageID        uint64 `json:"page_id"`
+	PageNamespace *int   `json:"page_namespace"`
+	PageTitle     string `json:"page_title"`
+}
+
+// petScanJSON is the result of a PetScan query with `format=json`
+type petScanJSON struct {
+	Results []struct {
+		A struct {
+			Pages []petScanPage `json:"*"`
+		} `json:"a"`
+	} `json:"*"`
+	Pages []petScanPage `json:"pages"`
+}
+
+func (t *ImporterServer) ImportFromPetScan(ctx context.Context, req *models.ImportFromPetScanRequest) (*models.ImportResponse, error) {
+	if req.Path == "" {
+		return nil, fmt.Errorf("path of the petscan result is required")
+	}
+	source := NewPetScanSource(req.Path, req.Temporary)
+	go t.importFrom(context.Background(), source, req.TaskId, req.RoundId)
+	return &models.ImportResponse{}, nil
+}
+func NewPetScanSource(path string, temporary bool) *PetScanSource {
+	return &PetScanSource{
+		path:      path,
+		temporary: temporary,
+	}
+}
+
+// load reads the files of the result once, the pages of the other namespaces and the duplicates are skipped
+func (t *PetScanSource) load() error {
+	fp, err := os.Open(t.path)
+	if err != nil {
+		return err
+	}
+	defer fp.Close() //nolint:errcheck
+	if t.temporary {
+		// Delete the uploaded file after we are done
+		defer os.Remove(t.path) //nolint:errcheck
+	}
+	result := &petScanJSON{}
+	if err := json.NewDecoder(fp).Decode(result); err != nil {
+		return err
+	}
+	pages := result.Pages
+	for _, r := range result.Results {
+		pages = append(pages, r.A.Pages...)
+	}
+	seenIds := map[uint64]struct{}{}
+	seenTitles := map[string]struct{}{}
+	t.pageIds = []uint64{}
+	t.titles = []string{}
+	for _, page := range pages {
+		pageID, namespace, title := page.ID, page.Namespace, page.Title
+		if pageID == 0 && title == "" {
+			pageID, namespace, title = page.PageID, page.PageNamespace, page.PageTitle
+		}
+		if namespace != nil && *namespace != fileNamespace {
+			continue
+		}
+		if pageID != 0 {
+			if _, ok := seenIds[pageID]; !ok {
+				seenIds[pageID] = struct{}{}
+				t.pageIds = append(t.pageIds, pageID)
+			}
+			continue
+		}
+		title = normalizeFileTitle(title)
+		if title == "" {
+			continue
+		}
+		if _, ok := seenTitles[title]; !ok {
+			seenTitles[title] = struct{}{}
+			t.titles = append(t.titles, title)
+		}
+	}
+	t.loaded = true
+	log.Printf("Loaded %d page IDs and %d titles from the petscan result", len(t.pageIds), len(t.titles))
+	return nil
+}
+
+// ImportImageResults fetches the next batch of the listed files from the Commons replica,
+// first by the page IDs and then by the titles of the pages without an ID.
+func (t *PetScanSource) ImportImageResults(ctx context.Context, currentRound *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string) {
+	if !t.loaded {
+		if err := t.load(); err != nil {
+			log.Printf("Error reading the petscan result: %s", err)
+			(*failedImageReason)["*"] = "Failed to read the petscan result: " + err.Error()
+			t.failed = true
+			return nil, failedImageReason
+		}
+	}
+	if t.index >= len(t.pageIds)+len(t.titles) {
+		return nil, failedImageReason
+	}
+	q, close := repository.GetCommonsReplicaWithGen(ctx)
+	defer close()
+	var data []models.CommonsSubmissionEntry
+	var err error
+	found := map[string]struct{}{}
+	requested := []string{}
+	if t.index < len(t.pageIds) {
+		endIndex := min(t.index+petScanBatchSize, len(t.pageIds))
+		batch := t.pageIds[t.index:endIndex]
+		log.Printf("Processing petscan page IDs from index %d to %d", t.index, endIndex)
+		t.index = endIndex
+		data, err = q.CommonsSubmissionEntry.FetchSubmissionsFromCommonsDBByPageID(batch, len(batch))
+		for _, submission := range data {
+			found[fmt.Sprint(submission.PageID)] = struct{}{}
+		}
+		for _, pageID := range batch {
+			requested = append(requested, fmt.Sprint(pageID))
+		}
+	} else {
+		start := t.index - len(t.pageIds)
+		endIndex := min(start+titleBatchSize, len(t.titles))
+		batch := t.titles[start:endIndex]
+		log.Printf("Processing petscan titles from index %d to %d", start, endIndex)
+		t.index = len(t.pageIds) + endIndex
+		data, err = q.CommonsSubmissionEntry.FetchSubmissionsFromCommonsDBByTitle(batch, len(batch))
+		for _, submission := range data {
+			found[submission.PageTitle] = struct{}{}
+		}
+		requested = batch
+	}
+	if err != nil {
+		log.Printf("Error importing images from petscan: %s", err)
+		(*failedImageReason)["*"] = err.Error()
+		t.failed = true
+		return nil, failedImageReason
+	}
+	result := []models.MediaResult{}
+	for _, submission := range data {
+		result = append(result, commonsEntryToMediaResult(submission))
+	}
+	for _, key := range requested {
+		if _, ok := found[key]; !ok {
+			(*failedImageReason)[key] = "File not found on Commons"
+		}
+	}
+	return result, failedImageReason
+}
+
+// Done reports whether all the listed files have been fetched, a batch of missing files is empty
+func (t *PetScanSource) Done() bool {
+	return t.failed || t.index >= len(t.pageIds)+len(t.titles)
+}
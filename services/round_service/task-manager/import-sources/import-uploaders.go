@@ -0,0 +1,95 @@
+package importsources
+
+import (
+	"context"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"nokib/campwiz/services/round_service"
+	"time"
+)
+
+type UploaderListSource struct {
+	Usernames []string
+	// StartDate and EndDate default to the dates of the campaign
+	StartDate  *time.Time
+	EndDate    *time.Time
+	lastPageID uint64
+	done       bool
+}
+
+func (t *ImporterServer) ImportFromUploaders(ctx context.Context, req *models.ImportFromUploadersRequest) (*models.ImportResponse, error) {
+	var startDate, endDate *time.Time
+	if req.StartTimestamp != 0 {
+		d := time.Unix(req.StartTimestamp, 0).UTC()
+		startDate = &d
+	}
+	if req.EndTimestamp != 0 {
+		d := time.Unix(req.EndTimestamp, 0).UTC()
+		endDate = &d
+	}
+	source := NewUploaderListSource(req.Usernames, startDate, endDate)
+	go t.importFrom(context.Background(), source, req.TaskId, req.RoundId)
+	return &models.ImportResponse{
+		TaskId:  req.TaskId,
+		RoundId: req.RoundId,
+	}, nil
+}
+
+func NewUploaderListSource(usernames []string, startDate *time.Time, endDate *time.Time) *UploaderListSource {
+	seen := map[string]struct{}{}
+	normalizedUsernames := []string{}
+	for _, username := range usernames {
+		username = round_service.NormalizeUsername(username)
+		if username == "" {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		normalizedUsernames = append(normalizedUsernames, username)
+	}
+	return &UploaderListSource{
+		Usernames: normalizedUsernames,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+}
+
+// ImportImageResults imports the next batch of the files uploaded by the users, in the order of the page IDs
+func (u *UploaderListSource) ImportImageResults(ctx context.Context, currentRound *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string) {
+	const batchSize = 15000
+	if u.done || len(u.Usernames) == 0 {
+		return nil, failedImageReason
+	}
+	startDate, endDate := currentRound.Campaign.StartDate, currentRound.Campaign.EndDate
+	if u.StartDate != nil {
+		startDate = *u.StartDate
+	}
+	if u.EndDate != nil {
+		endDate = *u.EndDate
+	}
+	allowedtypes := []string{}
+	for _, mediatype := range currentRound.AllowedMediaTypes {
+		allowedtypes = append(allowedtypes, string(mediatype))
+	}
+	q, close := repository.GetCommonsReplicaWithGen(ctx)
+	defer close()
+	log.Printf("Importing the files of %d uploaders after page ID %d", len(u.Usernames), u.lastPageID)
+	data, err := q.CommonsSubmissionEntry.FetchSubmissionsFromCommonsDBByUploader(u.Usernames, u.lastPageID, models.Date2Int(startDate), models.Date2Int(endDate), batchSize, allowedtypes)
+	if err != nil {
+		log.Printf("Error importing images by uploader: %s", err)
+		(*failedImageReason)["*"] = err.Error()
+		return nil, failedImageReason
+	}
+	if len(data) < batchSize {
+		u.done = true
+	}
+	result := []models.MediaResult{}
+	for _, submission := range data {
+		u.lastPageID = max(u.lastPageID, submission.PageID)
+		result = append(result, commonsEntryToMediaResult(submission))
+	}
+	return result, failedImageReason
+}
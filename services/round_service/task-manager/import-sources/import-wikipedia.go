@@ -0,0 +1,123 @@
+package importsources
+
+import (
+	"context"
+	"log"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	"strings"
+	"time"
+)
+
+// Every article needs a few requests to the API, so the batches are small
+const articleBatchSize = 50
+
+type WikipediaArticleSource struct {
+	Language string
+	// If empty, the articles created within the window are imported
+	Titles []string
+	// StartDate and EndDate default to the dates of the campaign
+	StartDate *time.Time
+	EndDate   *time.Time
+	index     int
+	loaded    bool
+	wiki      *repository.WikipediaRepository
+}
+
+func (t *ImporterServer) ImportFromWikipedia(ctx context.Context, req *models.ImportFromWikipediaRequest) (*models.ImportResponse, error) {
+	var startDate, endDate *time.Time
+	if req.StartTimestamp != 0 {
+		d := time.Unix(req.StartTimestamp, 0).UTC()
+		startDate = &d
+	}
+	if req.EndTimestamp != 0 {
+		d := time.Unix(req.EndTimestamp, 0).UTC()
+		endDate = &d
+	}
+	source, err := NewWikipediaArticleSource(req.Language, req.Titles, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	go t.importFrom(context.Background(), source, req.TaskId, req.RoundId)
+	return &models.ImportResponse{
+		TaskId:  req.TaskId,
+		RoundId: req.RoundId,
+	}, nil
+}
+func NewWikipediaArticleSource(language string, titles []string, startDate *time.Time, endDate *time.Time) (*WikipediaArticleSource, error) {
+	wiki, err := repository.NewWikipediaRepository(language, nil)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	normalizedTitles := []string{}
+	for _, title := range titles {
+		title = strings.TrimSpace(strings.ReplaceAll(title, "_", " "))
+		if title == "" {
+			continue
+		}
+		if _, ok := seen[title]; ok {
+			continue
+		}
+		seen[title] = struct{}{}
+		normalizedTitles = append(normalizedTitles, title)
+	}
+	return &WikipediaArticleSource{
+		Language:  language,
+		Titles:    normalizedTitles,
+		StartDate: startDate,
+		EndDate:   endDate,
+		wiki:      wiki,
+	}, nil
+}
+
+func (s *WikipediaArticleSource) window(round *models.Round) (time.Time, time.Time) {
+	startDate, endDate := round.Campaign.StartDate, round.Campaign.EndDate
+	if s.StartDate != nil {
+		startDate = *s.StartDate
+	}
+	if s.EndDate != nil {
+		endDate = *s.EndDate
+	}
+	return startDate, endDate
+}
+
+// ImportImageResults computes the contributions to the next batch of the articles within the window.
+// If no titles were given, the articles created within the window are listed on the first invocation.
+func (s *WikipediaArticleSource) ImportImageResults(ctx context.Context, currentRound *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string) {
+	startDate, endDate := s.window(currentRound)
+	if !s.loaded {
+		if len(s.Titles) == 0 {
+			titles, err := s.wiki.ListCreatedArticles(startDate, endDate)
+			if err != nil {
+				log.Printf("Error listing the created articles: %s", err)
+				(*failedImageReason)["*"] = "Failed to list the created articles: " + err.Error()
+				return nil, failedImageReason
+			}
+			s.Titles = titles
+		}
+		s.loaded = true
+	}
+	if s.index >= len(s.Titles) {
+		return nil, failedImageReason
+	}
+	result := []models.MediaResult{}
+	endIndex := min(s.index+articleBatchSize, len(s.Titles))
+	log.Printf("Processing %s.wikipedia articles from index %d to %d", s.Language, s.index, endIndex)
+	for _, title := range s.Titles[s.index:endIndex] {
+		article, err := s.wiki.GetArticleResult(title, startDate, endDate)
+		if err != nil {
+			log.Printf("Error fetching the article %s: %s", title, err)
+			(*failedImageReason)[title] = err.Error()
+			continue
+		}
+		result = append(result, *article)
+	}
+	s.index = endIndex
+	return result, failedImageReason
+}
+
+// Done reports whether all the articles have been processed, a batch of the missing articles is empty
+func (s *WikipediaArticleSource) Done() bool {
+	return s.index >= len(s.Titles)
+}
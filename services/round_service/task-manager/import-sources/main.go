@@ -12,6 +12,7 @@ import (
 	"nokib/campwiz/services/round_service"
 	"strings"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -29,16 +30,20 @@ type IImportSource interface {
 	// If there are failed images it should return the reason as a map
 	ImportImageResults(ctx context.Context, round *models.Round, failedImageReason *map[string]string) ([]models.MediaResult, *map[string]string)
 }
+
+// IImportSourceWithDone is implemented by the sources whose batches can be empty before the end,
+// e.g. when none of the titles of a batch are found on Commons
+type IImportSourceWithDone interface {
+	IImportSource
+	// Done reports whether all the batches have been fetched, an empty batch only ends the import once it is true
+	Done() bool
+}
 type IImportSourceWithPostProcessing interface {
 	IImportSource
 	// This method is called after the import is done to perform any post-processing
 	PostProcess(ctx context.Context, conn *gorm.DB, round *models.Round, task *models.Task, pageIdMap map[uint64]types.SubmissionIDType, newlyCreatedUsers map[models.WikimediaUsernameType]models.IDType) error
 }
 
-type IDistributionStrategy interface {
-	AssignJuries(tx *gorm.DB, round *models.Round, juries []models.Role) (success int, fail int, err error)
-}
-
 func (imp *ImporterServer) updateRoundStatistics(tx *gorm.DB, round *models.Round, successCount, failedCount int) error {
 	q := query.Use(tx)
 	if err := q.SubmissionStatistics.TriggerByRoundId(round.RoundID.String()); err != nil {
@@ -48,6 +53,35 @@ func (imp *ImporterServer) updateRoundStatistics(tx *gorm.DB, round *models.Roun
 	return q.RoundStatistics.UpdateByRoundID(round.RoundID.String())
 
 }
+
+// recordFailures stores the reason of every rejected file in the task and returns the number of failures
+func recordFailures(task *models.Task, failedImages *map[string]string) int {
+	failedIds := datatypes.NewJSONType(*failedImages)
+	task.FailedIds = &failedIds
+	task.FailedCount = len(*failedImages)
+	return task.FailedCount
+}
+
+// commonsEntryToMediaResult converts a file fetched from the Commons replica to the format of the import pipeline
+func commonsEntryToMediaResult(submission models.CommonsSubmissionEntry) models.MediaResult {
+	thumbURL, thumbWidth, thumbHeight := submission.GetThumbURL()
+	return models.MediaResult{
+		PageID:              submission.PageID,
+		Name:                submission.PageTitle,
+		URL:                 submission.GetURL(),
+		CreatedByUsername:   models.WikimediaUsernameType(submission.UserName),
+		SubmittedByUsername: models.WikimediaUsernameType(submission.UserName),
+		SubmittedAt:         submission.GetSubmittedAt(),
+		Height:              submission.FrHeight,
+		Width:               submission.FrWidth,
+		Size:                submission.FrSize,
+		MediaType:           submission.FtMediaType,
+		Resolution:          submission.FrWidth * submission.FrHeight,
+		ThumbURL:            &thumbURL,
+		ThumbWidth:          &thumbWidth,
+		ThumbHeight:         &thumbHeight,
+	}
+}
 func NewImporterServer() *ImporterServer {
 	return &ImporterServer{}
 }
@@ -131,6 +165,7 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 			Status:         task.Status,
 			SuccessCount:   successCount,
 			FailedCount:    failedCount,
+			FailedIds:      task.FailedIds,
 			RemainingCount: 0,
 		})
 		if res.Error != nil {
@@ -166,6 +201,16 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 		task.Status = models.TaskStatusFailed
 		return
 	}
+	if err = technicalJudge.LoadOfficials(tx, currentRound); err != nil {
+		log.Println("Error fetching the juries and coordinators: ", err)
+		task.Status = models.TaskStatusFailed
+		return
+	}
+	if err = technicalJudge.LoadArticleCounts(tx, currentRound); err != nil {
+		log.Println("Error counting the submitted articles: ", err)
+		task.Status = models.TaskStatusFailed
+		return
+	}
 	user_repo := repository.NewUserRepository()
 	pageIdMap := map[uint64]types.SubmissionIDType{}
 	newlyCreatedUsers := map[models.WikimediaUsernameType]models.IDType{}
@@ -175,10 +220,13 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 		successBatch, failedBatch := source.ImportImageResults(ctx, currentRound, FailedImages)
 		log.Printf("Received batch of images: %d success, %d failed\n", len(successBatch), len(*FailedImages))
 		if failedBatch != nil {
-			task.FailedCount = len(*failedBatch)
-			// *task.FailedIds = datatypes.NewJSONType(*failedBatch)
+			FailedImages = failedBatch
 		}
 		if len(successBatch) == 0 {
+			if withDone, ok := source.(IImportSourceWithDone); ok && !withDone.Done() {
+				continue
+			}
+			failedCount = recordFailures(task, FailedImages)
 			break
 		}
 		images := []models.MediaResult{}
@@ -196,6 +244,7 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 		}
 		successCount += len(images)
 		task.SuccessCount = successCount
+		failedCount = recordFailures(task, FailedImages)
 		participants := map[models.WikimediaUsernameType]models.IDType{}
 		for _, image := range images {
 			if image.CreatedByUsername != "" {
@@ -254,6 +303,9 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 				submission.ThumbWidth = *image.ThumbWidth
 				submission.ThumbHeight = *image.ThumbHeight
 			}
+			if image.Article != nil {
+				submission.ArticleSubmission = *image.Article
+			}
 			pageIdMap[image.PageID] = sId
 			submissions = append(submissions, submission)
 			submissionCount++
@@ -292,7 +344,6 @@ func (t *ImporterServer) importFrom(ctx context.Context, source IImportSource, t
 			}
 		}
 
-		// *task.FailedIds = datatypes.NewJSONType(*failedBatch)
 		res := tx.Save(task)
 		if res.Error != nil {
 			err = res.Error
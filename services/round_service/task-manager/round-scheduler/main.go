@@ -0,0 +1,127 @@
+package roundscheduler
+
+import (
+	"context"
+	"log"
+	"nokib/campwiz/consts"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const defaultSchedulerInterval = time.Minute
+
+// RoundScheduler periodically moves the rounds through their lifecycle
+// based on their start and end dates.
+//   - A SCHEDULED round becomes ACTIVE once its start date has passed.
+//   - An ACTIVE or EVALUATING round becomes PAUSED (or COMPLETED, if configured) once its end date has passed.
+type RoundScheduler struct {
+	Interval          time.Duration
+	CompleteOnEndDate bool
+}
+
+func NewRoundScheduler() *RoundScheduler {
+	interval := defaultSchedulerInterval
+	completeOnEndDate := false
+	if consts.Config != nil {
+		if consts.Config.TaskManager.Scheduler.IntervalSeconds > 0 {
+			interval = time.Duration(consts.Config.TaskManager.Scheduler.IntervalSeconds) * time.Second
+		}
+		completeOnEndDate = consts.Config.TaskManager.Scheduler.CompleteOnEndDate
+	}
+	return &RoundScheduler{
+		Interval:          interval,
+		CompleteOnEndDate: completeOnEndDate,
+	}
+}
+
+// Start runs the scheduler until the context is cancelled.
+func (s *RoundScheduler) Start(ctx context.Context) {
+	log.Printf("Round scheduler started with interval %v", s.Interval)
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	s.Tick(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Round scheduler stopped")
+			return
+		case now := <-ticker.C:
+			s.Tick(ctx, now)
+		}
+	}
+}
+
+// Tick performs a single pass over the rounds whose start or end date has passed.
+func (s *RoundScheduler) Tick(ctx context.Context, now time.Time) {
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	defer close()
+	q := query.Use(conn)
+	Round := q.Round
+	toBeStarted, err := Round.Select(Round.RoundID).
+		Where(Round.Status.Eq(string(models.RoundStatusScheduled))).
+		Where(Round.StartDate.Lte(now)).
+		Find()
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	for _, round := range toBeStarted {
+		s.transition(conn, round.RoundID, models.RoundStatusScheduled, models.RoundStatusActive)
+	}
+	endStatus := models.RoundStatusPaused
+	runningStatuses := []string{string(models.RoundStatusActive), string(models.RoundStatusEvaluating)}
+	if s.CompleteOnEndDate {
+		endStatus = models.RoundStatusCompleted
+		runningStatuses = append(runningStatuses, string(models.RoundStatusPaused))
+	}
+	toBeEnded, err := Round.Select(Round.RoundID, Round.Status, Round.EndDate).
+		Where(Round.Status.In(runningStatuses...)).
+		Where(Round.EndDate.Lte(now)).
+		Find()
+	if err != nil {
+		log.Println("Error: ", err)
+		return
+	}
+	for _, round := range toBeEnded {
+		if round.EndDate.IsZero() {
+			// The end date was never set, so the round would be ended manually
+			continue
+		}
+		s.transition(conn, round.RoundID, round.Status, endStatus)
+	}
+}
+
+// transition changes the status of a round only if it is still in the expected status
+// and recomputes the statistics of the round.
+func (s *RoundScheduler) transition(conn *gorm.DB, roundID models.IDType, from models.RoundStatus, to models.RoundStatus) {
+	tx := conn.Begin()
+	q := query.Use(tx)
+	res, err := q.Round.Where(q.Round.RoundID.Eq(roundID.String()), q.Round.Status.Eq(string(from))).
+		Update(q.Round.Status, string(to))
+	if err != nil {
+		log.Println("Error: ", err)
+		tx.Rollback()
+		return
+	}
+	if res.RowsAffected == 0 {
+		// The status was changed by someone else in the meantime
+		tx.Rollback()
+		return
+	}
+	round_repo := repository.NewRoundRepository()
+	if err := round_repo.UpdateFullStatisticsByRoundID(tx, roundID); err != nil {
+		log.Println("Error: ", err)
+		tx.Rollback()
+		return
+	}
+	tx.Commit()
+	log.Printf("Round %s moved from %s to %s by the scheduler", roundID, from, to)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"nokib/campwiz/repository/cache"
 	distributionstrategy "nokib/campwiz/services/round_service/task-manager/distribution-strategy"
 	importsources "nokib/campwiz/services/round_service/task-manager/import-sources"
+	roundscheduler "nokib/campwiz/services/round_service/task-manager/round-scheduler"
 	statisticsupdater "nokib/campwiz/services/round_service/task-manager/statistics-updater"
 
 	"google.golang.org/grpc"
@@ -47,6 +49,10 @@ func main() {
 	models.RegisterDistributorServer(grpcServer, distributionstrategy.NewDistributorServer())
 	models.RegisterStatisticsUpdaterServer(grpcServer, statisticsupdater.NewStatisticsUpdaterServer())
 
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	go roundscheduler.NewRoundScheduler().Start(schedulerCtx)
+
 	log.Printf("Task Manager Server listening at %v", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
@@ -2,7 +2,22 @@ package round_service
 
 import (
 	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RejectReasonBlacklisted       = "blacklisted-user"
+	RejectReasonJuryParticipant   = "jury-not-allowed-to-participate"
+	RejectReasonCoordinatorAuthor = "coordinator-not-allowed-to-participate"
+	RejectReasonMinimumResolution = "below-minimum-resolution"
+	RejectReasonMinimumSize       = "below-minimum-size"
+	RejectReasonMinimumDuration   = "below-minimum-duration"
 )
 
 type TechnicalJudgeService struct {
@@ -11,20 +26,109 @@ type TechnicalJudgeService struct {
 	MinimumResolution uint64
 	MinimumSize       uint64
 	MaximumUploadDate time.Time
+	AudioRestrictions models.RoundAudioRestrictions
+	VideoRestrictions models.RoundVideoRestrictions
 	// This would be a list of persons who are not allowed to submit images
 	// Thes include the banned users, judges, coordinators, moderators etc
 	Blacklist []string
+	// The juries and the coordinators of the round (username -> reason), filled by LoadOfficials
+	// only when the juries are not allowed to participate
+	Officials map[models.WikimediaUsernameType]string
+	// The articles are only judged for the wikipedia campaigns
+	CampaignType        models.CampaignType
+	ArticleRestrictions models.RoundArticleRestrictions
+	// How many times an article has been submitted so far, seeded by LoadArticleCounts
+	articleCount map[string]int
 }
 
 func NewTechnicalJudgeService(round *models.Round, campaign *models.Campaign) *TechnicalJudgeService {
 	return &TechnicalJudgeService{
-		AllowedTypes:      round.AllowedMediaTypes,
-		MinimumUploadDate: campaign.StartDate,
-		MaximumUploadDate: campaign.EndDate,
-		MinimumResolution: uint64(round.ImageMinimumResolution),
-		MinimumSize:       uint64(round.ArticleMinimumTotalBytes),
-		Blacklist:         []string{},
+		AllowedTypes:        round.AllowedMediaTypes,
+		MinimumUploadDate:   campaign.StartDate,
+		MaximumUploadDate:   campaign.EndDate,
+		MinimumResolution:   uint64(round.ImageMinimumResolution),
+		MinimumSize:         uint64(round.ImageMinimumSizeBytes),
+		AudioRestrictions:   round.RoundAudioRestrictions,
+		VideoRestrictions:   round.RoundVideoRestrictions,
+		Blacklist:           ParseBlacklist(round.Blacklist),
+		Officials:           map[models.WikimediaUsernameType]string{},
+		CampaignType:        campaign.CampaignType,
+		ArticleRestrictions: round.RoundArticleRestrictions,
+		articleCount:        map[string]int{},
+	}
+}
+
+// ParseBlacklist splits the blacklist of a round (separated by commas or new lines) into normalized usernames
+func ParseBlacklist(blacklist string) []string {
+	usernames := []string{}
+	for _, entry := range strings.FieldsFunc(blacklist, func(r rune) bool { return r == ',' || r == '\n' || r == '|' }) {
+		username := NormalizeUsername(entry)
+		if username != "" {
+			usernames = append(usernames, username)
+		}
 	}
+	return usernames
+}
+
+// NormalizeUsername makes the usernames comparable the way wikimedia does
+// (e.g. "User:Foo_bar" and "foo bar" are the same user), which is also the `user_name` format of the replica
+func NormalizeUsername(username string) string {
+	username = strings.TrimSpace(username)
+	username = strings.TrimPrefix(username, "User:")
+	username = strings.TrimSpace(strings.ReplaceAll(username, "_", " "))
+	if username == "" {
+		return ""
+	}
+	// The first letter of a username is always capitalized on the wikis
+	first, size := utf8.DecodeRuneInString(username)
+	return string(unicode.ToUpper(first)) + username[size:]
+}
+
+// LoadOfficials fetches the juries of the round and the coordinators of the campaign
+// so that their own submissions can be rejected when the round does not allow the juries to participate.
+func (j *TechnicalJudgeService) LoadOfficials(tx *gorm.DB, round *models.Round) error {
+	if round.AllowJuryToParticipate {
+		return nil
+	}
+	Role := query.Use(tx).Role
+	roles, err := Role.Preload(Role.User).
+		Where(Role.Where(Role.RoundID.Eq(round.RoundID.String()), Role.Type.Eq(string(models.RoleTypeJury)))).
+		Or(Role.Where(Role.CampaignID.Eq(round.CampaignID.String()), Role.Type.Eq(string(models.RoleTypeCoordinator)))).
+		Find()
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.User == nil {
+			continue
+		}
+		username := models.WikimediaUsernameType(NormalizeUsername(string(role.User.Username)))
+		if role.Type == models.RoleTypeJury {
+			j.Officials[username] = RejectReasonJuryParticipant
+		} else if _, ok := j.Officials[username]; !ok {
+			j.Officials[username] = RejectReasonCoordinatorAuthor
+		}
+	}
+	return nil
+}
+
+// participantRejectReason checks whether the creator or the submitter is allowed to participate
+func (j *TechnicalJudgeService) participantRejectReason(submission models.MediaResult) string {
+	for _, participant := range []models.WikimediaUsernameType{submission.CreatedByUsername, submission.SubmittedByUsername} {
+		if participant == "" {
+			continue
+		}
+		username := NormalizeUsername(string(participant))
+		for _, blacklisted := range j.Blacklist {
+			if blacklisted == username {
+				return RejectReasonBlacklisted
+			}
+		}
+		if reason, ok := j.Officials[models.WikimediaUsernameType(username)]; ok {
+			return reason
+		}
+	}
+	return ""
 }
 
 // This method would perform some basic checks to see if the image is prevented from submission
@@ -34,7 +138,14 @@ func NewTechnicalJudgeService(round *models.Round, campaign *models.Campaign) *T
 //   - Minimum Resolution
 //   - Minimum Size
 //   - Whether Image allowed or not
+//
+// - For the participants
+//   - Whether the creator or the submitter is blacklisted
+//   - Whether the creator or the submitter is a jury or a coordinator (unless the juries are allowed to participate)
 func (j *TechnicalJudgeService) RejectReason(submission models.MediaResult) string {
+	if reason := j.participantRejectReason(submission); reason != "" {
+		return reason
+	}
 	if j.AllowedTypes != nil && !j.AllowedTypes.Contains(models.MediaType(submission.MediaType)) {
 		// log.Printf("Image %s is not allowed because it is of type %s", img.Name, img.MediaType)
 		return "not-allowed-type"
@@ -47,14 +158,36 @@ func (j *TechnicalJudgeService) RejectReason(submission models.MediaResult) stri
 		// log.Printf("Image %s is not allowed because it was uploaded after %s", img.Name, j.MaximumUploadDate)
 		return "after-maximum-upload-date"
 	}
-	mt := models.MediaType(submission.MediaType)
-	if (mt == models.MediaTypeImage || mt == models.MediaTypeVideo) && submission.Resolution < j.MinimumResolution {
-		// log.Printf("Image %s is not allowed because it has a resolution of %d which is less than %d", img.Name, img.Resolution, j.MinimumResolution)
-		return "below-minimum-resolution"
+	if j.CampaignType == models.CampaignTypeWikipedia {
+		return j.articleRejectReason(submission)
 	}
-	if submission.Size < j.MinimumSize {
-		// log.Printf("Image %s is not allowed because it has a size of %d which is less than %d", img.Name, img.Size, j.MinimumSize)
-		return "below-minimum-size"
+	switch models.MediaType(submission.MediaType) {
+	case models.MediaTypeImage:
+		if submission.Resolution < j.MinimumResolution {
+			// log.Printf("Image %s is not allowed because it has a resolution of %d which is less than %d", img.Name, img.Resolution, j.MinimumResolution)
+			return RejectReasonMinimumResolution
+		}
+		if submission.Size < j.MinimumSize {
+			// log.Printf("Image %s is not allowed because it has a size of %d which is less than %d", img.Name, img.Size, j.MinimumSize)
+			return RejectReasonMinimumSize
+		}
+	case models.MediaTypeAudio:
+		if submission.Duration < uint64(j.AudioRestrictions.AudioMinimumDurationMilliseconds) {
+			return RejectReasonMinimumDuration
+		}
+		if submission.Size < uint64(j.AudioRestrictions.AudioMinimumSizeBytes) {
+			return RejectReasonMinimumSize
+		}
+	case models.MediaTypeVideo:
+		if submission.Duration < uint64(j.VideoRestrictions.VideoMinimumDurationMilliseconds) {
+			return RejectReasonMinimumDuration
+		}
+		if submission.Size < uint64(j.VideoRestrictions.VideoMinimumSizeBytes) {
+			return RejectReasonMinimumSize
+		}
+		if submission.Resolution < uint64(j.VideoRestrictions.VideoMinimumResolution) {
+			return RejectReasonMinimumResolution
+		}
 	}
 
 	return ""
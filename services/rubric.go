@@ -0,0 +1,42 @@
+package services
+
+import (
+	"fmt"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+
+	"gorm.io/gorm"
+)
+
+// rescoreRubricEvaluations recomputes the score of the evaluations that were scored with the rubric
+// of the round, so that a change of the weights is reflected in the results.
+// The change is rejected if the criteria of the existing evaluations do not match the new rubric.
+func (r *RoundService) rescoreRubricEvaluations(tx *gorm.DB, round *models.Round) error {
+	if round.Rubric == nil {
+		return nil
+	}
+	rubric := round.Rubric.Data()
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	evaluations, err := Evaluation.Select(Evaluation.EvaluationID, Evaluation.CriteriaScores).
+		Where(Evaluation.RoundID.Eq(round.RoundID.String()), Evaluation.CriteriaScores.IsNotNull()).Find()
+	if err != nil {
+		return err
+	}
+	if len(evaluations) == 0 {
+		return nil
+	}
+	for _, evaluation := range evaluations {
+		score, err := rubric.WeightedScore(evaluation.CriteriaScores.Data())
+		if err != nil {
+			return fmt.Errorf("rubric does not match the existing evaluation %s : %w", evaluation.EvaluationID, err)
+		}
+		_, err = Evaluation.Where(Evaluation.EvaluationID.Eq(evaluation.EvaluationID.String())).Update(Evaluation.Score, score)
+		if err != nil {
+			return err
+		}
+	}
+	round_repo := repository.NewRoundRepository()
+	return round_repo.UpdateFullStatisticsByRoundID(tx, round.RoundID)
+}
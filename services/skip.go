@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const DefaultSkipDuration = 24 * time.Hour
+
+// SkipEvaluation hides an assignment from the jury until the skip expires.
+// If the round has a SkipReassignmentThreshold and that many distinct juries have skipped the submission,
+// the assignment is handed to the least loaded jury of the round who does not have the submission yet.
+func (e *EvaluationService) SkipEvaluation(ctx context.Context, currentUserID models.IDType, evaluationID models.IDType, req *models.SkipRequest) (*models.Evaluation, error) {
+	ev_repo := repository.NewEvaluationRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	evaluation, err := ev_repo.FindEvaluationByID(tx.Preload("Submission").Preload("Submission.Round").Preload("Judge"), evaluationID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if evaluation.Judge == nil || evaluation.Judge.UserID != currentUserID {
+		tx.Rollback()
+		return nil, errors.New("evaluation is not assigned to the user")
+	}
+	if evaluation.EvaluatedAt != nil {
+		tx.Rollback()
+		return nil, errors.New("evaluation is already evaluated")
+	}
+	round := evaluation.Submission.Round
+	if round == nil {
+		tx.Rollback()
+		return nil, errors.New("round not found")
+	}
+	duration := DefaultSkipDuration
+	if req != nil && req.DurationMinutes > 0 {
+		duration = time.Duration(req.DurationMinutes) * time.Minute
+	}
+	expiration := time.Now().UTC().Add(duration)
+	// The skip count of the evaluation is reset on reassignment, so the first skip of the current jury is a new one
+	if evaluation.SkipCount == 0 {
+		Submission := query.Use(tx).Submission
+		if _, err := Submission.Where(Submission.SubmissionID.Eq(evaluation.SubmissionID.String())).UpdateSimple(Submission.SkippedJuryCount.Add(1)); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		evaluation.Submission.SkippedJuryCount++
+	}
+	evaluation.SkipCount++
+	evaluation.SkipExpirationAt = &expiration
+	reassigned := false
+	if round.SkipReassignmentThreshold > 0 && evaluation.Submission.SkippedJuryCount >= round.SkipReassignmentThreshold {
+		replacement, err := findSkipReplacement(tx, round, evaluation)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if replacement != nil {
+			evaluation.JudgeID = &replacement.RoleID
+			evaluation.Judge = replacement
+			evaluation.SkipCount = 0
+			evaluation.SkipExpirationAt = nil
+			reassigned = true
+		}
+	}
+	res := tx.Model(&models.Evaluation{EvaluationID: evaluation.EvaluationID}).Updates(map[string]any{
+		"judge_id":           evaluation.JudgeID,
+		"skip_count":         evaluation.SkipCount,
+		"skip_expiration_at": evaluation.SkipExpirationAt,
+	})
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+	if reassigned {
+		q := query.Use(tx)
+		if err := q.JuryStatistics.TriggerByRoundID(round.RoundID.String()); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	tx.Commit()
+	hideAuthors(round, evaluation)
+	return evaluation, nil
+}
+
+// findSkipReplacement returns the jury of the round with the least assignments
+// who is neither the submitter, nor in conflict with, nor already assigned to the submission
+func findSkipReplacement(tx *gorm.DB, round *models.Round, evaluation *models.Evaluation) (*models.Role, error) {
+	role_repo := repository.NewRoleRepository()
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(tx, &models.RoleFilter{RoundID: &round.RoundID, Type: &juryType})
+	if err != nil {
+		return nil, err
+	}
+	q := query.Use(tx)
+	assigned, err := q.Evaluation.Select(q.Evaluation.JudgeID).
+		Where(q.Evaluation.SubmissionID.Eq(evaluation.SubmissionID.String()), q.Evaluation.JudgeID.IsNotNull()).Find()
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := repository.NewConflictRepository().FetchIndex(tx, round.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	alreadyAssigned := map[models.IDType]bool{}
+	for _, a := range assigned {
+		alreadyAssigned[*a.JudgeID] = true
+	}
+	var replacement *models.Role
+	for i := range juries {
+		jury := &juries[i]
+		if alreadyAssigned[jury.RoleID] || jury.UserID == evaluation.Submission.SubmittedByID || conflicts.Conflicts(jury.RoleID, evaluation.Submission) {
+			continue
+		}
+		if replacement == nil || jury.TotalAssigned < replacement.TotalAssigned {
+			replacement = jury
+		}
+	}
+	return replacement, nil
+}
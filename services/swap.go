@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/models/types"
+	"nokib/campwiz/query"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const swapFailureNoCounterpart = "no-swappable-assignment"
+
+// SwapAssignments exchanges the unevaluated assignments of a jury with the unevaluated assignments of the other juries.
+// The counterparts are chosen by FetchTargetSwappables, so that no jury ends up with the same submission twice,
+// and no jury would receive a submission of their own. The swap is recorded as a new distribution task.
+func (r *RoundService) SwapAssignments(ctx context.Context, currentUserID models.IDType, roundID models.IDType, req *models.SwapRequest) (*models.Task, error) {
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if round.Status == models.RoundStatusCompleted {
+		tx.Rollback()
+		return nil, errors.New("round is already completed")
+	}
+	juryRole, err := findSwappingJury(tx, currentUserID, round, req.JuryUsername)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	q := query.Use(tx)
+	Evaluation := q.Evaluation
+	stmt := Evaluation.Preload(Evaluation.Submission).Where(Evaluation.RoundID.Eq(roundID.String()),
+		Evaluation.JudgeID.Eq(juryRole.RoleID.String()), Evaluation.Score.IsNull())
+	if len(req.EvaluationIDs) > 0 {
+		ids := []string{}
+		for _, id := range req.EvaluationIDs {
+			ids = append(ids, id.String())
+		}
+		stmt = stmt.Where(Evaluation.EvaluationID.In(ids...))
+	}
+	mine, err := stmt.Find()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(mine) == 0 {
+		tx.Rollback()
+		return nil, errors.New("no unevaluated assignments to swap")
+	}
+	targets, err := Evaluation.FetchTargetSwappables(roundID.String(), juryRole.RoleID.String(), len(mine))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	targetSubmissions, judgeUsers, err := swapParticipants(q, targets)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	conflicts, err := repository.NewConflictRepository().FetchIndex(tx, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	failedIds := map[string]string{}
+	task := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeSwapAssignments,
+		Status:               models.TaskStatusSuccess,
+		AssociatedRoundID:    &roundID,
+		AssociatedUserID:     &juryRole.UserID,
+		CreatedByID:          currentUserID,
+		AssociatedCampaignID: &round.CampaignID,
+	}
+	if _, err := task_repo.Create(tx, task); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	used := map[models.IDType]bool{}
+	for _, evaluation := range mine {
+		var counterpart *models.Evaluation
+		for _, target := range targets {
+			if used[target.EvaluationID] || target.JudgeID == nil {
+				continue
+			}
+			// neither of the juries may receive their own submission or a submission they have a conflict with
+			targetSubmission := targetSubmissions[target.SubmissionID]
+			if targetSubmission == nil || judgeUsers[*target.JudgeID] == evaluation.Submission.SubmittedByID || juryRole.UserID == targetSubmission.SubmittedByID {
+				continue
+			}
+			if conflicts.Conflicts(*target.JudgeID, evaluation.Submission) || conflicts.Conflicts(juryRole.RoleID, targetSubmission) {
+				continue
+			}
+			counterpart = target
+			break
+		}
+		if counterpart == nil {
+			failedIds[evaluation.EvaluationID.String()] = swapFailureNoCounterpart
+			continue
+		}
+		used[counterpart.EvaluationID] = true
+		if err := reassignEvaluation(tx, evaluation.EvaluationID, *counterpart.JudgeID, task.TaskID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := reassignEvaluation(tx, counterpart.EvaluationID, juryRole.RoleID, task.TaskID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		task.SuccessCount++
+	}
+	task.FailedCount = len(failedIds)
+	failed := datatypes.NewJSONType(failedIds)
+	task.FailedIds = &failed
+	if task.SuccessCount == 0 {
+		task.Status = models.TaskStatusFailed
+	}
+	if _, err := task_repo.Update(tx, task); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	return task, nil
+}
+
+// findSwappingJury returns the jury role whose assignments would be swapped.
+// A jury can swap their own assignments, a coordinator of the campaign can swap on behalf of any jury.
+func findSwappingJury(tx *gorm.DB, currentUserID models.IDType, round *models.Round, username models.WikimediaUsernameType) (*models.Role, error) {
+	role_repo := repository.NewRoleRepository()
+	user_repo := repository.NewUserRepository()
+	userID := currentUserID
+	if username != "" {
+		existing, err := user_repo.FetchExistingUsernames(tx, []models.WikimediaUsernameType{username})
+		if err != nil {
+			return nil, err
+		}
+		id, ok := existing[username]
+		if !ok {
+			return nil, errors.New("user not found")
+		}
+		userID = id
+	}
+	if userID != currentUserID {
+		coordinatorType := models.RoleTypeCoordinator
+		coordinators, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &currentUserID, CampaignID: &round.CampaignID, Type: &coordinatorType})
+		if err != nil {
+			return nil, err
+		}
+		if len(coordinators) == 0 {
+			return nil, errors.New("only the coordinators can swap the assignments of others")
+		}
+	}
+	juryType := models.RoleTypeJury
+	juries, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &userID, RoundID: &round.RoundID, Type: &juryType})
+	if err != nil {
+		return nil, err
+	}
+	if len(juries) == 0 {
+		return nil, errors.New("user is not a jury of the round")
+	}
+	return &juries[0], nil
+}
+
+// swapParticipants returns the target submissions and the users behind the target juries
+func swapParticipants(q *query.Query, targets []*models.Evaluation) (map[types.SubmissionIDType]*models.Submission, map[models.IDType]models.IDType, error) {
+	targetSubmissions := map[types.SubmissionIDType]*models.Submission{}
+	judgeUsers := map[models.IDType]models.IDType{}
+	if len(targets) == 0 {
+		return targetSubmissions, judgeUsers, nil
+	}
+	submissionIds := []string{}
+	judgeIds := []string{}
+	for _, target := range targets {
+		submissionIds = append(submissionIds, target.SubmissionID.String())
+		if target.JudgeID != nil {
+			judgeIds = append(judgeIds, target.JudgeID.String())
+		}
+	}
+	submissions, err := q.Submission.Select(q.Submission.SubmissionID, q.Submission.SubmittedByID, q.Submission.ParticipantID).
+		Where(q.Submission.SubmissionID.In(submissionIds...)).Find()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, submission := range submissions {
+		targetSubmissions[submission.SubmissionID] = submission
+	}
+	roles, err := q.Role.Select(q.Role.RoleID, q.Role.UserID).Where(q.Role.RoleID.In(judgeIds...)).Find()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, role := range roles {
+		judgeUsers[role.RoleID] = role.UserID
+	}
+	return targetSubmissions, judgeUsers, nil
+}
+
+// reassignEvaluation hands an evaluation to another jury as a part of a distribution task
+func reassignEvaluation(tx *gorm.DB, evaluationID models.IDType, judgeID models.IDType, taskID models.IDType) error {
+	res := tx.Model(&models.Evaluation{EvaluationID: evaluationID}).Updates(map[string]any{
+		"judge_id":             judgeID,
+		"distribution_task_id": taskID,
+		"skip_count":           0,
+		"skip_expiration_at":   nil,
+	})
+	return res.Error
+}
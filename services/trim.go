@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+)
+
+// TrimRedundantEvaluations deletes the pending assignments of the submissions that have already reached the quorum.
+// It lets the coordinators over-assign the submissions for speed and clean up at the end. The trim is recorded as a task.
+func (r *RoundService) TrimRedundantEvaluations(ctx context.Context, currentUserID models.IDType, roundID models.IDType) (*models.Task, error) {
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	role_repo := repository.NewRoleRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	coordinatorType := models.RoleTypeCoordinator
+	coordinators, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &currentUserID, CampaignID: &round.CampaignID, Type: &coordinatorType})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(coordinators) == 0 {
+		tx.Rollback()
+		return nil, errors.New("only the coordinators can trim the redundant evaluations")
+	}
+	deleted, err := round_repo.TrimRedundantEvaluations(tx, round)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	task := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeTrimAssignments,
+		Status:               models.TaskStatusSuccess,
+		AssociatedRoundID:    &roundID,
+		AssociatedUserID:     &currentUserID,
+		CreatedByID:          currentUserID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         deleted,
+	}
+	if _, err := task_repo.Create(tx, task); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	return task, nil
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"nokib/campwiz/models"
+	"nokib/campwiz/repository"
+	idgenerator "nokib/campwiz/services/idGenerator"
+)
+
+const undoneTaskDataKey = "undone-task"
+
+// UndoDistribution unassigns the unevaluated evaluations that were moved by a finished distribution (or a swap) task.
+// The evaluated ones are kept as is. The unassigned evaluations would be picked up by the next distribution.
+// If the round got stuck in the distributing status, its previous status is restored. The undo is recorded as a new task.
+func (r *RoundService) UndoDistribution(ctx context.Context, currentUserID models.IDType, roundID models.IDType, taskID models.IDType) (*models.Task, error) {
+	round_repo := repository.NewRoundRepository()
+	task_repo := repository.NewTaskRepository()
+	role_repo := repository.NewRoleRepository()
+	conn, close, err := repository.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer close()
+	tx := conn.Begin()
+	round, err := round_repo.FindByID(tx, roundID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if round.Status == models.RoundStatusCompleted {
+		tx.Rollback()
+		return nil, errors.New("round is already completed")
+	}
+	coordinatorType := models.RoleTypeCoordinator
+	coordinators, err := role_repo.ListAllRoles(tx, &models.RoleFilter{UserID: &currentUserID, CampaignID: &round.CampaignID, Type: &coordinatorType})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(coordinators) == 0 {
+		tx.Rollback()
+		return nil, errors.New("only the coordinators can undo a distribution")
+	}
+	distributionTask, err := task_repo.FindByID(tx, taskID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	unassigned, err := task_repo.UndoDistribution(tx, round, distributionTask)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	key := undoneTaskDataKey
+	task := &models.Task{
+		TaskID:               idgenerator.GenerateID("t"),
+		Type:                 models.TaskTypeUndoDistribution,
+		Status:               models.TaskStatusSuccess,
+		AssociatedRoundID:    &roundID,
+		AssociatedUserID:     &currentUserID,
+		CreatedByID:          currentUserID,
+		AssociatedCampaignID: &round.CampaignID,
+		SuccessCount:         unassigned,
+		TaskData: []models.TaskData{{
+			DataID: idgenerator.GenerateID("d"),
+			Key:    &key,
+			Value:  taskID.String(),
+		}},
+	}
+	if _, err := task_repo.Create(tx, task); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	return task, nil
+}